@@ -68,9 +68,9 @@ func main() {
 	s := Statistics{}
 
 	s.AccountID = file.First.AccountID
-	s.CoalEarned = file.Last.Resources[wows.Coal].Earned
-	s.SteelEarned = file.Last.Resources[wows.Steel].Earned
-	s.ContainersEarned = file.Last.Resources[wows.SantaGiftContainer].Earned
+	s.CoalEarned = file.Last.Resources.Get(wows.Coal).Earned
+	s.SteelEarned = file.Last.Resources.Get(wows.Steel).Earned
+	s.ContainersEarned = file.Last.Resources.Get(wows.SantaGiftContainer).Earned
 
 	for idx, ship := range file.Last.Ships {
 		firstShip := file.First.Ships[idx]
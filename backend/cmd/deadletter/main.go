@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: deadletter [flags] <command>
+
+Commands:
+  list                 list all dead-lettered refreshes
+  inspect <accountId>  show a dead-lettered refresh
+  replay <accountId>   send the refresh again and remove it from the dead-letter table
+  replay all           replay every dead-lettered refresh
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	topic := flag.String("topic", os.Getenv("TOPIC_ARN"), "ARN of the SNS topic refreshes are sent to when using the sns queue backend (replay only)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *topic != "" {
+		os.Setenv("TOPIC_ARN", *topic)
+	}
+
+	switch flag.Arg(0) {
+	case "list":
+		list()
+	case "inspect":
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		inspect(flag.Arg(1))
+	case "replay":
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		replay(flag.Arg(1))
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func list() {
+	deadLetters, err := storage.ListDeadLetters()
+	if err != nil {
+		log.Fatalf("Could not list dead letters: %v", err)
+	}
+
+	sort.Slice(deadLetters, func(i, j int) bool {
+		return deadLetters[i].DeadLetteredAt > deadLetters[j].DeadLetteredAt
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACCOUNT\tREALM\tATTEMPTS\tREASON\tDEAD-LETTERED")
+	for _, d := range deadLetters {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", d.AccountID, d.Realm, d.Attempt, d.Reason, formatTime(d.DeadLetteredAt))
+	}
+	w.Flush()
+}
+
+func inspect(accountID string) {
+	deadLetter, err := storage.GetDeadLetter(accountID)
+	if err != nil {
+		log.Fatalf("Could not get dead letter: %v", err)
+	}
+	if deadLetter == nil {
+		log.Fatalf("No dead letter for accountId=%s", accountID)
+	}
+
+	fmt.Printf("Account:        %s\n", deadLetter.AccountID)
+	fmt.Printf("Realm:          %s\n", deadLetter.Realm)
+	fmt.Printf("Attempts:       %d\n", deadLetter.Attempt)
+	fmt.Printf("Reason:         %s\n", deadLetter.Reason)
+	fmt.Printf("Error:          %s\n", deadLetter.Error)
+	fmt.Printf("First failed:   %s\n", formatTime(deadLetter.FirstFailedAt))
+	fmt.Printf("Last failed:    %s\n", formatTime(deadLetter.LastFailedAt))
+	fmt.Printf("Dead-lettered:  %s\n", formatTime(deadLetter.DeadLetteredAt))
+
+	subscriber, err := storage.GetSubscriber(accountID)
+	if err != nil {
+		log.Printf("WARN: could not get subscriber: %v", err)
+		return
+	}
+
+	data, _ := json.MarshalIndent(struct {
+		Active        bool
+		DataURL       string
+		LastUpdated   string
+		LastScheduled string
+	}{subscriber.Active, subscriber.DataURL, formatTime(subscriber.LastUpdated), formatTime(subscriber.LastScheduled)}, "", "  ")
+	fmt.Printf("Subscriber:     %s\n", data)
+}
+
+func replay(accountID string) {
+	var deadLetters []*storage.FailedRefresh
+	if accountID == "all" {
+		var err error
+		deadLetters, err = storage.ListDeadLetters()
+		if err != nil {
+			log.Fatalf("Could not list dead letters: %v", err)
+		}
+	} else {
+		deadLetter, err := storage.GetDeadLetter(accountID)
+		if err != nil {
+			log.Fatalf("Could not get dead letter: %v", err)
+		}
+		if deadLetter == nil {
+			log.Fatalf("No dead letter for accountId=%s", accountID)
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	replayed := 0
+	for _, deadLetter := range deadLetters {
+		subscriber, err := storage.GetSubscriber(deadLetter.AccountID)
+		if err != nil {
+			log.Printf("ERROR: could not get subscriber accountId=%s error=%v", deadLetter.AccountID, err)
+			continue
+		}
+		if !subscriber.Active {
+			log.Printf("WARN: subscriber is not active, skipping accountId=%s", deadLetter.AccountID)
+			continue
+		}
+
+		// A replay starts over with a fresh set of attempts
+		if err := storage.TriggerRefresh([]storage.RefreshEvent{subscriber.RefreshEvent()}); err != nil {
+			log.Printf("ERROR: could not send refresh accountId=%s error=%v", deadLetter.AccountID, err)
+			continue
+		}
+
+		if err := storage.DeleteDeadLetter(deadLetter.AccountID); err != nil {
+			log.Printf("ERROR: could not delete dead letter accountId=%s error=%v", deadLetter.AccountID, err)
+		}
+
+		log.Printf("Replayed accountId=%s", deadLetter.AccountID)
+		replayed++
+	}
+
+	log.Printf("Replayed %d of %d dead letters", replayed, len(deadLetters))
+}
+
+func formatTime(nanos int64) string {
+	if nanos == 0 {
+		return "-"
+	}
+	return time.Unix(0, nanos).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"sort"
+)
+
+// migrate upgrades all public subscriber data to the current schema version
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only report the objects that would be migrated")
+	concurrency := flag.Int("concurrency", 16, "number of objects migrated at the same time")
+	reportFile := flag.String("report", "", "write the report as JSON to this file")
+	flag.Parse()
+
+	log.Printf("Migrating to schemaVersion=%d dryRun=%t", storage.CurrentSchemaVersion, *dryRun)
+	for _, migration := range storage.Migrations {
+		log.Printf("  %d -> %d: %s", migration.From, migration.From+1, migration.Description)
+	}
+
+	report, err := storage.MigratePublicSubscriberData(context.Background(), *dryRun, *concurrency)
+	if err != nil {
+		log.Printf("ERROR: migration stopped early error=%v", err)
+	}
+	if report == nil {
+		os.Exit(1)
+	}
+
+	log.Printf("Migration done total=%d current=%d migrated=%d failed=%d", report.Total, report.Current, report.Migrated, len(report.Failed))
+	for version, count := range report.FromVersion {
+		log.Printf("  fromVersion=%d count=%d", version, count)
+	}
+
+	sort.Slice(report.Failed, func(i, j int) bool { return report.Failed[i].Key < report.Failed[j].Key })
+	for _, failure := range report.Failed {
+		log.Printf("  FAILED key=%s error=%s", failure.Key, failure.Error)
+	}
+
+	if *reportFile != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Could not encode report: %v", err)
+		}
+		if err := ioutil.WriteFile(*reportFile, data, 0644); err != nil {
+			log.Fatalf("Could not write report: %v", err)
+		}
+	}
+
+	if err != nil || len(report.Failed) > 0 {
+		os.Exit(1)
+	}
+}
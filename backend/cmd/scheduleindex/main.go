@@ -0,0 +1,20 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"rukenshia/frenchwhaling/pkg/storage"
+)
+
+// scheduleindex adds subscribers that were created before the schedule index existed to it
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only log the subscribers that would be changed")
+	flag.Parse()
+
+	changed, err := storage.BackfillScheduleIndex(*dryRun)
+	if err != nil {
+		log.Fatalf("Backfill failed after changed=%d: %v", changed, err)
+	}
+
+	log.Printf("Backfill done changed=%d dryRun=%t", changed, *dryRun)
+}
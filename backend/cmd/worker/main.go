@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"rukenshia/frenchwhaling/pkg/queue"
+	"rukenshia/frenchwhaling/pkg/refresh"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// worker processes refreshes from a queue outside of lambda, e.g. with QUEUE_BACKEND=file for local development
+func main() {
+	batchSize := flag.Int("batch", 10, "number of refreshes processed at once")
+	flag.Parse()
+
+	consumer, err := queue.NewConsumer()
+	if err != nil {
+		log.Fatalf("Could not open queue: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		log.Printf("Stopping")
+		cancel()
+	}()
+
+	engine := refresh.NewEngine()
+	for ctx.Err() == nil {
+		messages, err := consumer.Receive(ctx, *batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("ERROR: could not receive messages error=%v", err)
+			}
+			continue
+		}
+		if len(messages) == 0 {
+			continue
+		}
+
+		if err := catalogue.Default.EnsureFresh(); err != nil {
+			log.Printf("WARN: could not update ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+		}
+
+		var refreshEvents []storage.RefreshEvent
+		for _, m := range messages {
+			var ev storage.RefreshEvent
+			if err := json.Unmarshal(m.Body, &ev); err != nil {
+				log.Printf("ERROR: could not parse refresh event key=%s error=%v", m.Key, err)
+				continue
+			}
+			refreshEvents = append(refreshEvents, ev)
+		}
+
+		summary := engine.Run(ctx, refreshEvents)
+		log.Printf("Processed batch count=%d %s", len(refreshEvents), summary)
+		refresh.ScheduleRetries(sentry.CurrentHub(), summary)
+
+		// Failed refreshes went to the retry queue, so every message is done
+		if err := consumer.Ack(context.Background(), messages); err != nil {
+			log.Printf("ERROR: could not acknowledge messages error=%v", err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/auth"
+	"rukenshia/frenchwhaling/pkg/push"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// Browsers cannot set headers on WebSocket connections, so the token is passed in the query string.
+func Handler(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.QueryStringParameters["accountId"]
+	connectionID := request.RequestContext.ConnectionID
+	log.Printf("Connect start accountId=%s connectionId=%s", accountID, connectionID)
+
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", accountID)
+		scope.SetLevel(sentry.LevelError)
+	})
+
+	if err := auth.VerifyToken(request.QueryStringParameters["token"], accountID); err != nil {
+		log.Printf("Connect unauthorized accountId=%s error=%v", accountID, err)
+		return Response{
+			StatusCode: 401,
+			Body:       "Unauthorized",
+		}, nil
+	}
+
+	if err := push.AddConnection(connectionID, accountID); err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("AddConnection failed")
+		log.Printf("ERROR: could not add connection accountId=%s error=%v", accountID, err)
+
+		return Response{
+			StatusCode: 500,
+			Body:       "Could not connect",
+		}, nil
+	}
+
+	return Response{
+		StatusCode: 200,
+		Body:       "Connected",
+	}, nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "connect",
+	})
+
+	lambda.Start(Handler)
+}
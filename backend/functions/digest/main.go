@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/auth"
+	"rukenshia/frenchwhaling/pkg/digest"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	awsEvents "github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+func textResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       body,
+		Headers: map[string]string{
+			"Content-Type":                "text/plain",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// PUT subscribes to the digest with the email address in the body, DELETE unsubscribes.
+func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.PathParameters["accountId"]
+	log.Printf("Digest start accountId=%s method=%s", accountID, request.HTTPMethod)
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", accountID)
+		scope.SetLevel(sentry.LevelError)
+	})
+
+	authz, ok := request.Headers["authorization"]
+	if !ok {
+		authz, ok = request.Headers["Authorization"]
+
+		if !ok {
+			return textResponse(401, "No authorization passed"), nil
+		}
+	}
+
+	if err := auth.VerifyToken(authz, accountID); err != nil {
+		getHub(sentryAccountHub, E{"token": authz}).CaptureException(err)
+		return textResponse(401, "Unauthorized"), nil
+	}
+
+	switch request.HTTPMethod {
+	case "PUT":
+		var body struct {
+			Email string
+		}
+		if err := json.Unmarshal([]byte(request.Body), &body); err != nil {
+			return textResponse(400, "Invalid body"), nil
+		}
+
+		if err := digest.Subscribe(accountID, body.Email); err != nil {
+			if err == digest.ErrInvalidEmail {
+				return textResponse(400, err.Error()), nil
+			}
+
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Subscribe failed")
+			log.Printf("ERROR: could not subscribe to digest accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not subscribe"), nil
+		}
+		return textResponse(200, "OK"), nil
+
+	case "DELETE":
+		if err := digest.Unsubscribe(accountID); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Unsubscribe failed")
+			log.Printf("ERROR: could not unsubscribe from digest accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not unsubscribe"), nil
+		}
+		return textResponse(200, "OK"), nil
+	}
+
+	return textResponse(405, "Method not allowed"), nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "digest",
+	})
+
+	lambda.Start(Handler)
+}
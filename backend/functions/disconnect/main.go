@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/push"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+func Handler(ctx context.Context, request events.APIGatewayWebsocketProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	connectionID := request.RequestContext.ConnectionID
+	log.Printf("Disconnect connectionId=%s", connectionID)
+
+	if err := push.RemoveConnection(connectionID); err != nil {
+		// Not fatal, the connection expires on its own and is removed when sending to it fails
+		log.Printf("WARN: could not remove connection connectionId=%s error=%v", connectionID, err)
+	}
+
+	return Response{
+		StatusCode: 200,
+		Body:       "Disconnected",
+	}, nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "disconnect",
+	})
+
+	lambda.Start(Handler)
+}
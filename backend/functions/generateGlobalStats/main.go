@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"os"
+	"rukenshia/frenchwhaling/pkg/stats"
 	"rukenshia/frenchwhaling/pkg/storage"
-	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"time"
 
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,69 +19,148 @@ import (
 	"github.com/getsentry/sentry-go"
 )
 
-type Response = string
+type E map[string]interface{}
 
-type Statistics []storage.EarnableResource
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+// Request is the payload the function gets called with
+type Request struct {
+	// Reconcile rebuilds the statistics from the data of all subscribers and corrects the counters
+	Reconcile bool
+}
+
+// Result is what the function did
+type Result struct {
+	Reconciled bool
+	// Subscribers is the number of subscribers that were read by a reconciliation
+	Subscribers int
+	// Skipped are the objects a reconciliation could not read
+	Skipped []storage.SkippedObject
+	// Drift are the counters that were corrected by a reconciliation
+	Drift []stats.Drift
+}
+
+// statisticsKey is where the versioned statistics are published, statistics.json keeps the old format
+var statisticsKey = fmt.Sprintf("statistics/v%d.json", stats.Version)
+
+// Handler publishes the statistics. The counters are kept up to date by the updateStats function, the participation
+// and completion of the subscribers are taken from the last reconciliation.
+func Handler(ctx context.Context, request Request) (*Result, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	// Tiers and nations come from the catalogue, it is only read here and kept up to date by the refresh
+	if err := catalogue.Default.Load(); err != nil {
+		log.Printf("WARN: could not load ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+	}
 
-func Handler(ctx context.Context) (*Response, error) {
-	data, err := storage.GetAllPublicSubscriberData()
+	statistics, err := stats.LoadReconciled(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if request.Reconcile || statistics == nil {
+		return reconcile(ctx)
+	}
 
-	// The first is republic tokens which we don't care about :peeposhrug:
-	resources := []*storage.EarnableResource{
-		{Type: wows.Coal},
-		{Type: wows.Steel},
-		{Type: wows.NewYearCertificate},
+	counters, err := stats.LoadCounters(ctx)
+	if err != nil {
+		return nil, err
 	}
+	statistics.GeneratedAt = time.Now().UTC()
+	statistics.SetCounters(counters)
+	log.Printf("Statistics generated from counters counters=%d realms=%d", len(counters), len(statistics.Realms))
+
+	return &Result{}, publish(statistics)
+}
 
-	for _, subscriber := range data {
-		for _, ship := range subscriber.Ships {
-			for _, resourceType := range resources {
-				if resourceType.Type == ship.Resource.Type {
-					resourceType.Amount += ship.Resource.Amount
-					resourceType.Earned += ship.Resource.Earned
-				}
-			}
+// reconcile computes the statistics from the data of all subscribers, reports and corrects the counters that drifted
+// from them and publishes the result
+func reconcile(ctx context.Context) (*Result, error) {
+	builder := stats.NewBuilder(time.Now())
+	report, err := storage.NewIterator(16).Each(ctx, func(key string, data *storage.SubscriberPublicData) error {
+		builder.Add(data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Skipped) > 0 {
+		getHub(sentry.CurrentHub(), E{"skipped": report.Skipped}).CaptureMessage("Could not read subscriber data")
+	}
+	statistics := builder.Statistics()
+	log.Printf("Statistics rebuilt subscribers=%d players=%d active=%d realms=%d", statistics.Global.Participation.Subscribers, statistics.Global.Participation.Players, statistics.Global.Participation.Active, len(statistics.Realms))
+
+	// Events that arrive while the data is read show up as drift and are corrected by the next reconciliation
+	counters, err := stats.LoadCounters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{
+		Reconciled:  true,
+		Subscribers: report.Read,
+		Skipped:     report.Skipped,
+		Drift:       counters.Diff(builder.Counters()),
+	}
+	if len(result.Drift) > 0 {
+		for _, drift := range result.Drift {
+			log.Printf("WARN: counter drifted key=%s counter=%+v rebuilt=%+v", drift.Key, drift.Counter, drift.Rebuilt)
 		}
+		getHub(sentry.CurrentHub(), E{"drift": len(result.Drift), "counters": len(counters)}).CaptureMessage("Statistics counters drifted")
 	}
 
-	for _, resourceType := range resources {
-		log.Printf("%+v", resourceType)
+	// Without the skipped objects the rebuilt counters are too low, the counters are only corrected by a complete rebuild
+	if len(report.Skipped) > 0 {
+		log.Printf("WARN: not correcting counters, objects were skipped skipped=%d", len(report.Skipped))
+	} else if err := counters.Corrections(builder.Counters()).Update(ctx); err != nil {
+		return nil, err
+	}
+	if err := statistics.SaveReconciled(ctx); err != nil {
+		return nil, err
 	}
 
-	// Upload
+	log.Printf("Statistics reconciled counters=%d drift=%d", len(counters), len(result.Drift))
+	return result, publish(statistics)
+}
+
+func publish(statistics *stats.Statistics) error {
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config: aws.Config{
 			Region: aws.String("eu-central-1"),
 		},
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 	svc := s3manager.NewUploader(sess)
 
-	resourceData, err := json.Marshal(resources)
-	if err != nil {
-		return nil, err
+	if err := upload(svc, statisticsKey, statistics); err != nil {
+		return err
 	}
+	return upload(svc, "statistics.json", statistics.Legacy())
+}
 
-	if _, err := svc.Upload(&s3manager.UploadInput{
-		Bucket: aws.String("whaling.in.fkn.space"),
-		Key:    aws.String("statistics.json"),
-		Body:   bytes.NewBuffer(resourceData),
-		ACL:    aws.String("public-read"),
-	}); err != nil {
-		return nil, err
+func upload(svc *s3manager.Uploader, key string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
 
-	res := Response("ok")
-	return &res, nil
+	_, err = svc.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String("whaling.in.fkn.space"),
+		Key:         aws.String(key),
+		Body:        bytes.NewBuffer(body),
+		ACL:         aws.String("public-read"),
+		ContentType: aws.String("application/json"),
+	})
+	return err
 }
 
 func main() {
-	Handler(context.Background())
 	sentry.Init(sentry.ClientOptions{
 		Dsn:        os.Getenv("SENTRY_DSN"),
 		ServerName: "generateGlobalStats",
@@ -2,11 +2,17 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"rukenshia/frenchwhaling/pkg/auth"
 	"rukenshia/frenchwhaling/pkg/events"
 	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"sort"
 	"strconv"
 	"time"
 
@@ -24,6 +30,13 @@ type Response awsEvents.APIGatewayProxyResponse
 
 type E map[string]interface{}
 
+var (
+	errNoData          = errors.New("subscriber has no data yet")
+	errUnknownShip     = errors.New("unknown ship for player")
+	errAlreadyRedeemed = errors.New("already redeemed")
+	errTooManyShips    = fmt.Errorf("at most %d ships can be marked at once", maxBulkShips)
+)
+
 func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
 	h := hub.Clone()
 	h.ConfigureScope(func(scope *sentry.Scope) {
@@ -36,7 +49,7 @@ func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
 func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
 	defer sentry.Flush(5 * time.Second)
 
-	log.Printf("MarkAsPlayed start accountId=%s shipId=%s", request.PathParameters["accountId"], request.PathParameters["shipId"])
+	log.Printf("MarkAsPlayed start accountId=%s shipId=%s method=%s", request.PathParameters["accountId"], request.PathParameters["shipId"], request.HTTPMethod)
 	sentryAccountHub := sentry.CurrentHub().Clone()
 	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
 		scope.SetTag("AccountID", request.PathParameters["accountId"])
@@ -50,14 +63,7 @@ func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Res
 
 		if !ok {
 			log.Printf("missing authz accountId=%s shipId=%s", request.PathParameters["accountId"], request.PathParameters["shipId"])
-			return Response{
-				StatusCode: 401,
-				Body:       "No authorization passed",
-				Headers: map[string]string{
-					"Content-Type":                "text/plain",
-					"Access-Control-Allow-Origin": "*",
-				},
-			}, nil
+			return textResponse(401, "No authorization passed"), nil
 		}
 	}
 
@@ -65,14 +71,7 @@ func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Res
 		log.Printf("token not valid err=%s accountId=%s shipId=%s", err.Error(), request.PathParameters["accountId"], request.PathParameters["shipId"])
 		getHub(sentryAccountHub, E{"token": authz}).CaptureException(err)
 
-		return Response{
-			StatusCode: 401,
-			Body:       "Unauthorized",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+		return textResponse(401, "Unauthorized"), nil
 	}
 
 	log.Printf("Token verified, getting subscriber")
@@ -82,108 +81,255 @@ func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Res
 		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetSubscriber failed")
 		log.Printf("ERROR: could not get subscriber accountId=%s error=%v", request.PathParameters["accountId"], err)
 
-		return Response{
-			StatusCode: 404,
-			Body:       "Not found",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+		return textResponse(404, "Not found"), nil
 	}
 
-	subscriberData, err := storage.LoadPublicSubscriberData(subscriber.DataURL)
-	if err != nil {
-		return Response{
-			StatusCode: 500,
-			Body:       "Could not find subscriber data",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+	// Without a ship in the path, the ships are selected by the body
+	if request.PathParameters["shipId"] == "" {
+		return bulkMarkAsPlayed(ctx, sentryAccountHub, subscriber, request.Body), nil
 	}
 
 	shipId, err := strconv.Atoi(request.PathParameters["shipId"])
 	if err != nil {
-		return Response{
-			StatusCode: 400,
-			Body:       "Bad ship id",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+		return textResponse(400, "Bad ship id"), nil
 	}
-
 	shipId64 := int64(shipId)
+
+	// DELETE takes back a ship that was marked as played by mistake
+	unmark := request.HTTPMethod == "DELETE"
+
 	var ship *storage.StoredShip
-	for _, knownShip := range subscriberData.Ships {
-		if knownShip.ShipID == shipId64 {
-			ship = knownShip
+	_, err = storage.UpdatePublicSubscriberData(ctx, subscriber.AccountID, subscriber.DataURL, func(subscriberData *storage.SubscriberPublicData, isNew bool) error {
+		if isNew {
+			return errNoData
 		}
-	}
 
-	if ship == nil {
-		return Response{
-			StatusCode: 400,
-			Body:       "Unknown ship for player",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
-	}
+		ship = nil
+		for _, knownShip := range subscriberData.Ships {
+			if knownShip.ShipID == shipId64 {
+				ship = knownShip
+			}
+		}
+
+		if ship == nil {
+			return errUnknownShip
+		}
+
+		if unmark {
+			if err := ship.Unmark(); err != nil {
+				return err
+			}
+		} else if err := ship.MarkAsPlayed(time.Now()); err != nil {
+			return errAlreadyRedeemed
+		}
+
+		subscriberData.UpdateEarnedResources()
+		return nil
+	})
+	if err != nil {
+		cause := err
+		if uerr, ok := err.(*storage.UpdateError); ok {
+			cause = uerr.Err
+		}
+
+		switch cause {
+		case errNoData:
+			return textResponse(500, "Could not find subscriber data"), nil
+		case errUnknownShip:
+			return textResponse(400, "Unknown ship for player"), nil
+		case storage.ErrNotMarked, storage.ErrEarnedInBattle:
+			return textResponse(400, cause.Error()), nil
+		case errAlreadyRedeemed:
+			return textResponse(400, "Already redeemed"), nil
+		}
 
-	if ship.Resource.Earned == ship.Resource.Amount {
-		return Response{
-			StatusCode: 400,
-			Body:       "Already redeemed",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not save data to S3")
+		log.Printf("ERROR: Could not save data: accountId=%s error=%v", subscriber.AccountID, err)
+		return textResponse(500, "Error storing data"), nil
 	}
 
-	ship.Resource.Earned = ship.Resource.Amount
-	ship.ShipStatistics.LastBattleTime = int(time.Now().Unix())
-	ship.LastBattleTime = int(time.Now().Unix())
+	if unmark {
+		if err := events.Add(events.NewResourceRevoked(subscriber.AccountID, subscriber.Realm, ship.Resource.Type, ship.Resource.Amount, ship.ShipID, "unmarked")); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not send ResourceRevoked event")
+			log.Printf("WARN: could not send resource revoked event")
+		}
+
+		return textResponse(200, "OK"), nil
+	}
 
-	if err := events.Add(events.NewResourceEarned(subscriber.AccountID, ship.Resource.Type, ship.Resource.Amount, ship.ShipID, "manual")); err != nil {
+	if err := events.Add(events.NewResourceEarned(subscriber.AccountID, subscriber.Realm, ship.Resource.Type, ship.Resource.Amount, ship.ShipID, storage.BattleTypeManual)); err != nil {
 		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not send ResourceEarned event")
 		log.Printf("WARN: could not send resource earned event")
 	}
 
-	for i := range subscriberData.Resources {
-		subscriberData.Resources[i].Earned = 0
+	return textResponse(200, "Started"), nil
+}
+
+// maxBulkShips is the number of ships that can be marked at once
+const maxBulkShips = 500
+
+// BulkRequest selects the ships to mark as played, either by their IDs or by a filter over the ships of the subscriber
+type BulkRequest struct {
+	ShipIDs []int64
+	Filter  *ShipFilter
+}
+
+// ShipFilter selects ships that did not earn their resource yet. Fields that are not set match all ships.
+type ShipFilter struct {
+	Tier     int
+	Type     wows.ShipType
+	Nation   string
+	Resource *wows.Resource
+	// InPort only selects ships that are in the port of the subscriber
+	InPort bool
+}
+
+// Empty returns whether no field of the filter is set, an empty filter would select every ship
+func (f *ShipFilter) Empty() bool {
+	return f.Tier == 0 && f.Type == "" && f.Nation == "" && f.Resource == nil && !f.InPort
+}
+
+// Matches returns whether the ship is selected by the filter
+func (f *ShipFilter) Matches(ship *storage.StoredShip, warship wows.Warship) bool {
+	if ship.Resource.Earned > 0 {
+		return false
+	}
+	if f.InPort && (ship.ShipStatistics == nil || ship.Private == nil || !ship.Private.InGarage) {
+		return false
+	}
+	if f.Resource != nil && ship.Resource.Type != *f.Resource {
+		return false
+	}
+	return (f.Tier == 0 || warship.Tier == f.Tier) &&
+		(f.Type == "" || warship.Type == f.Type) &&
+		(f.Nation == "" || warship.Nation == f.Nation)
+}
+
+// BulkResponse lists what happened to the selected ships
+type BulkResponse struct {
+	Marked        []int64
+	AlreadyEarned []int64
+}
+
+// bulkMarkAsPlayed marks several ships as played in a single update of the subscriber data
+func bulkMarkAsPlayed(ctx context.Context, sentryAccountHub *sentry.Hub, subscriber *storage.Subscriber, body string) Response {
+	var req BulkRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		return textResponse(400, "Invalid body")
+	}
+	if len(req.ShipIDs) == 0 && req.Filter == nil {
+		return textResponse(400, "ShipIDs or Filter is required")
+	}
+	if req.Filter != nil && req.Filter.Empty() {
+		return textResponse(400, "Filter needs at least one field")
+	}
+	if len(req.ShipIDs) > maxBulkShips {
+		return textResponse(400, fmt.Sprintf("At most %d ships can be marked at once", maxBulkShips))
 	}
 
-	for _, ship := range subscriberData.Ships {
-		subscriberData.Resources[ship.Resource.Type].Earned += ship.Resource.Earned
+	if req.Filter != nil {
+		if err := catalogue.Default.EnsureFresh(); err != nil {
+			log.Printf("WARN: could not update ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+		}
 	}
 
-	if err := subscriberData.Save(subscriber.DataURL, false); err != nil {
+	var marked []*storage.StoredShip
+	var alreadyEarned []int64
+	_, err := storage.UpdatePublicSubscriberData(ctx, subscriber.AccountID, subscriber.DataURL, func(subscriberData *storage.SubscriberPublicData, isNew bool) error {
+		if isNew {
+			return errNoData
+		}
+
+		// The filter is applied to the freshly loaded data, as it may be loaded again on conflicts
+		shipIDs := req.ShipIDs
+		if req.Filter != nil {
+			shipIDs = append([]int64{}, req.ShipIDs...)
+			for shipID, ship := range subscriberData.Ships {
+				warship, ok := catalogue.Default.Get(shipID)
+				if ok && req.Filter.Matches(ship, warship) {
+					shipIDs = append(shipIDs, shipID)
+				}
+			}
+			sort.Slice(shipIDs, func(i, j int) bool { return shipIDs[i] < shipIDs[j] })
+			shipIDs = unique(shipIDs)
+		}
+		if len(shipIDs) > maxBulkShips {
+			return errTooManyShips
+		}
+
+		var err error
+		marked, alreadyEarned, err = subscriberData.MarkShipsAsPlayed(shipIDs, time.Now())
+		return err
+	})
+	if err != nil {
+		cause := err
+		if uerr, ok := err.(*storage.UpdateError); ok {
+			cause = uerr.Err
+		}
+
+		if cause == errNoData {
+			return textResponse(500, "Could not find subscriber data")
+		}
+		if cause == errTooManyShips {
+			return textResponse(400, fmt.Sprintf("At most %d ships can be marked at once, the filter selects more", maxBulkShips))
+		}
+		if _, ok := cause.(*storage.UnknownShipsError); ok {
+			return textResponse(400, cause.Error())
+		}
+
 		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not save data to S3")
 		log.Printf("ERROR: Could not save data: accountId=%s error=%v", subscriber.AccountID, err)
-		return Response{
-			StatusCode: 500,
-			Body:       "Error storing data",
-			Headers: map[string]string{
-				"Content-Type":                "text/plain",
-				"Access-Control-Allow-Origin": "*",
-			},
-		}, nil
+		return textResponse(500, "Error storing data")
+	}
+
+	res := BulkResponse{Marked: []int64{}, AlreadyEarned: []int64{}}
+	res.AlreadyEarned = append(res.AlreadyEarned, alreadyEarned...)
+	for _, ship := range marked {
+		res.Marked = append(res.Marked, ship.ShipID)
+
+		if err := events.Add(events.NewResourceEarned(subscriber.AccountID, subscriber.Realm, ship.Resource.Type, ship.Resource.Amount, ship.ShipID, storage.BattleTypeManual)); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error(), "shipId": ship.ShipID}).CaptureMessage("Could not send ResourceEarned event")
+			log.Printf("WARN: could not send resource earned event accountId=%s shipId=%d", subscriber.AccountID, ship.ShipID)
+		}
+	}
+	log.Printf("Marked ships as played accountId=%s marked=%d alreadyEarned=%d", subscriber.AccountID, len(res.Marked), len(res.AlreadyEarned))
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return textResponse(500, "Could not encode response")
+	}
+	return jsonResponse(200, data)
+}
+
+// unique removes repeated IDs from a sorted slice
+func unique(shipIDs []int64) []int64 {
+	var result []int64
+	for i, shipID := range shipIDs {
+		if i == 0 || shipID != shipIDs[i-1] {
+			result = append(result, shipID)
+		}
 	}
+	return result
+}
 
+// textResponse is the response of every route, except for the JSON of the bulk route
+func textResponse(statusCode int, body string) Response {
+	return response(statusCode, "text/plain", body)
+}
+
+func jsonResponse(statusCode int, body []byte) Response {
+	return response(statusCode, "application/json", string(body))
+}
+
+func response(statusCode int, contentType, body string) Response {
 	return Response{
-		StatusCode: 200,
-		Body:       "Started",
+		StatusCode: statusCode,
+		Body:       body,
 		Headers: map[string]string{
-			"Content-Type":                "text/plain",
+			"Content-Type":                contentType,
 			"Access-Control-Allow-Origin": "*",
 		},
-	}, nil
+	}
 }
 
 func main() {
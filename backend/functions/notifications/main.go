@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/auth"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	awsEvents "github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+func textResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       body,
+		Headers: map[string]string{
+			"Content-Type":                "text/plain",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// GET returns the registered webhook, PUT registers a webhook and DELETE removes it.
+func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.PathParameters["accountId"]
+	log.Printf("Notifications start accountId=%s method=%s", accountID, request.HTTPMethod)
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", accountID)
+		scope.SetLevel(sentry.LevelError)
+	})
+
+	authz, ok := request.Headers["authorization"]
+	if !ok {
+		authz, ok = request.Headers["Authorization"]
+
+		if !ok {
+			return textResponse(401, "No authorization passed"), nil
+		}
+	}
+
+	if err := auth.VerifyToken(authz, accountID); err != nil {
+		getHub(sentryAccountHub, E{"token": authz}).CaptureException(err)
+		return textResponse(401, "Unauthorized"), nil
+	}
+
+	switch request.HTTPMethod {
+	case "GET":
+		registration, err := notify.GetRegistration(accountID)
+		if err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetRegistration failed")
+			log.Printf("ERROR: could not get registration accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not get notification settings"), nil
+		}
+		if registration == nil {
+			return textResponse(404, "Not found"), nil
+		}
+
+		data, err := json.Marshal(registration)
+		if err != nil {
+			return textResponse(500, "Could not get notification settings"), nil
+		}
+		return Response{
+			StatusCode: 200,
+			Body:       string(data),
+			Headers: map[string]string{
+				"Content-Type":                "application/json",
+				"Access-Control-Allow-Origin": "*",
+			},
+		}, nil
+
+	case "PUT":
+		var registration notify.Registration
+		if err := json.Unmarshal([]byte(request.Body), &registration); err != nil {
+			return textResponse(400, "Invalid body"), nil
+		}
+		registration.AccountID = accountID
+		registration.CreatedAt = 0
+
+		if err := registration.Validate(); err != nil {
+			return textResponse(400, err.Error()), nil
+		}
+
+		if err := notify.PutRegistration(&registration); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("PutRegistration failed")
+			log.Printf("ERROR: could not save registration accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not save notification settings"), nil
+		}
+		log.Printf("Registered webhook accountId=%s kind=%s", accountID, registration.Kind)
+		return textResponse(200, "OK"), nil
+
+	case "DELETE":
+		if err := notify.DeleteRegistration(accountID); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("DeleteRegistration failed")
+			log.Printf("ERROR: could not delete registration accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not delete notification settings"), nil
+		}
+		return textResponse(200, "OK"), nil
+	}
+
+	return textResponse(405, "Method not allowed"), nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "notifications",
+	})
+
+	lambda.Start(Handler)
+}
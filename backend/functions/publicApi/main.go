@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/publicapi"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	awsEvents "github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+func textResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       body,
+		Headers: map[string]string{
+			"Content-Type":                "text/plain",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+func jsonResponse(statusCode int, v interface{}) Response {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return textResponse(500, "Could not encode response")
+	}
+	return Response{
+		StatusCode: statusCode,
+		Body:       string(data),
+		Headers: map[string]string{
+			"Content-Type":                "application/json",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// It serves the versioned read API described in openapi/v1.yaml. The API is public like the data of the subscribers,
+// which the frontend reads from S3.
+func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.PathParameters["accountId"]
+	log.Printf("PublicApi start accountId=%s resource=%s", accountID, request.Resource)
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", accountID)
+		scope.SetLevel(sentry.LevelError)
+	})
+
+	subscriber, err := storage.GetSubscriber(accountID)
+	if err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetSubscriber failed")
+		log.Printf("ERROR: could not get subscriber accountId=%s error=%v", accountID, err)
+		return textResponse(500, "Could not get subscriber"), nil
+	}
+	if subscriber.AccountID == "" {
+		return textResponse(404, "Not found"), nil
+	}
+
+	// The timeline is read from the history, which is kept apart from the current data
+	if strings.HasSuffix(request.Resource, "/timeline") {
+		history, err := storage.LoadHistory(ctx, accountID)
+		if err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("LoadHistory failed")
+			log.Printf("ERROR: could not load history accountId=%s error=%v", accountID, err)
+			return textResponse(500, "Could not load history"), nil
+		}
+		return jsonResponse(200, publicapi.NewTimeline(history)), nil
+	}
+
+	data, err := storage.LoadPublicSubscriberDataWithContext(ctx, subscriber.DataURL)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			// The first refresh has not finished yet
+			return textResponse(404, "Not found"), nil
+		}
+
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("LoadPublicSubscriberData failed")
+		log.Printf("ERROR: could not load subscriber data accountId=%s error=%v", accountID, err)
+		return textResponse(500, "Could not load subscriber data"), nil
+	}
+
+	switch {
+	case strings.HasSuffix(request.Resource, "/progress"):
+		return jsonResponse(200, publicapi.NewProgress(subscriber.Realm, data)), nil
+
+	case strings.HasSuffix(request.Resource, "/ships"):
+		if err := catalogue.Default.EnsureLoaded(); err != nil {
+			log.Printf("WARN: could not load ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+		}
+		return jsonResponse(200, publicapi.NewShips(data)), nil
+	}
+
+	return textResponse(404, "Not found"), nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "publicApi",
+	})
+
+	lambda.Start(Handler)
+}
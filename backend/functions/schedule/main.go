@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"rukenshia/frenchwhaling/pkg/schedule"
 	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/webhooks"
 	"sync"
 	"time"
 
+	"github.com/gammazero/workerpool"
 	"github.com/getsentry/sentry-go"
 
 	"github.com/aws/aws-lambda-go/lambda"
@@ -29,11 +35,48 @@ type Request struct {
 	RefreshAll bool
 }
 
+// Result is what the scheduler did
+type Result struct {
+	// Found is the number of subscribers that were not scheduled recently
+	Found int
+	// Selected is the number of subscribers that were due for a refresh
+	Selected int
+	// Scheduled is the number of subscribers whose refresh was sent
+	Scheduled int
+	// PublishFailed is the number of subscribers whose batch could not be sent, they are picked up by the next run
+	PublishFailed int
+	// MarkFailed is the number of subscribers that were sent, but could not be marked as scheduled
+	MarkFailed int
+	Retries    int
+	// Redeliveries is the number of failed webhook deliveries that were sent again
+	Redeliveries int
+	Errors       []string
+}
+
+func (r *Result) String() string {
+	return fmt.Sprintf("found=%d selected=%d scheduled=%d publishFailed=%d markFailed=%d retries=%d redeliveries=%d errors=%d",
+		r.Found, r.Selected, r.Scheduled, r.PublishFailed, r.MarkFailed, r.Retries, r.Redeliveries, len(r.Errors))
+}
+
+const (
+	// batchSize is the number of refresh events sent at once
+	batchSize = 100
+	// markConcurrency is the number of LastScheduled updates running at the same time
+	markConcurrency = 10
+)
+
 // Handler is the lambda handler invoked by the `lambda.Start` function call
-func Handler(ctx context.Context, request Request) (string, error) {
+func Handler(ctx context.Context, request Request) (*Result, error) {
 	defer sentry.Flush(5 * time.Second)
 	log.Printf("Scheduler started")
-	want := time.Now().Add(-(8) * time.Hour)
+
+	result := &Result{}
+	result.Retries = scheduleRetries(result)
+	result.Redeliveries = redeliverWebhooks(ctx, result)
+
+	now := time.Now()
+	// Nobody is refreshed more often than the active interval, so only those subscribers need a decision
+	want := now.Add(-schedule.ActiveInterval)
 
 	if request.RefreshAll {
 		want = time.Now()
@@ -41,70 +84,195 @@ func Handler(ctx context.Context, request Request) (string, error) {
 
 	log.Printf("Finding last scheduled want=%d", want.UnixNano())
 
-	subscribers, err := storage.FindUnscheduledSubscribers(want.UnixNano())
+	var batch []storage.RefreshEvent
+	err := storage.FindUnscheduledSubscribers(want.UnixNano(), func(subscribers []*storage.Subscriber) error {
+		result.Found += len(subscribers)
+		for _, subscriber := range subscribers {
+			if !request.RefreshAll {
+				decision := decide(now, subscriber)
+				log.Printf("Schedule decision accountId=%s refresh=%t interval=%s reason=%q", subscriber.AccountID, decision.Refresh, decision.Interval, decision.Reason)
+				if !decision.Refresh {
+					continue
+				}
+			}
+
+			log.Printf("Selected for scheduling accountId=%s lastScheduled=%d", subscriber.AccountID, subscriber.LastScheduled)
+			result.Selected++
+			batch = append(batch, subscriber.RefreshEvent())
+
+			if len(batch) >= batchSize {
+				sendBatch(result, batch)
+				batch = nil
+			}
+		}
+		return ctx.Err()
+	})
 	if err != nil {
-		getHub(sentry.CurrentHub(), E{"error": err}).CaptureException(fmt.Errorf("FindUnscheduledSubscribers failed"))
-		log.Fatalf("Could not find subscribers: %v", err)
+		getHub(sentry.CurrentHub(), E{"error": err.Error()}).CaptureException(fmt.Errorf("FindUnscheduledSubscribers failed"))
+		log.Printf("ERROR: could not find subscribers error=%v", err)
+		result.Errors = append(result.Errors, fmt.Sprintf("find subscribers: %v", err))
 	}
 
-	log.Printf("Found subscribers, sending refresh events subscribers=%d", len(subscribers))
+	// Subscribers found before an error are still sent
+	if len(batch) > 0 {
+		sendBatch(result, batch)
+	}
 
-	var batch []storage.RefreshEvent
-	var wg sync.WaitGroup
-	for _, subscriber := range subscribers {
-		sentryAccountHub := sentry.CurrentHub().Clone()
-		sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
-			scope.SetTag("AccountID", subscriber.AccountID)
-		})
+	log.Printf("Scheduler done %s", result)
+	return result, err
+}
 
-		log.Printf("Selected for scheduling accountId=%s lastScheduled=%d", subscriber.AccountID, subscriber.LastScheduled)
-		batch = append(batch, storage.RefreshEvent{
-			AccountID:            subscriber.AccountID,
-			Realm:                subscriber.Realm,
-			AccessToken:          subscriber.AccessToken,
-			AccessTokenExpiresAt: subscriber.AccessTokenExpiresAt,
-			DataURL:              subscriber.DataURL,
-		})
+// sendBatch publishes the refresh events and marks the subscribers as scheduled once that worked. If publishing
+// fails, LastScheduled stays untouched, so that the subscribers are picked up again by the next run.
+func sendBatch(result *Result, batch []storage.RefreshEvent) {
+	log.Printf("Sending batch of size=%d", len(batch))
 
-		wg.Add(1)
-		go func(accountID string) {
-			defer wg.Done()
+	if err := storage.TriggerRefresh(batch); err != nil {
+		getHub(sentry.CurrentHub(), E{"error": err.Error(), "size": len(batch)}).CaptureException(fmt.Errorf("TriggerRefresh failed"))
+		log.Printf("ERROR: sending batch error=%v", err)
+		result.PublishFailed += len(batch)
+		result.Errors = append(result.Errors, fmt.Sprintf("publish batch: %v", err))
+		return
+	}
+	result.Scheduled += len(batch)
 
-			if err := storage.SetSubscriberLastScheduled(accountID, time.Now().UnixNano()); err != nil {
-				sentryAccountHub.CaptureException(fmt.Errorf("SetSubscriberLastScheduled failed"))
-				log.Printf("ERROR: could not update last scheduled error=%v", err)
+	scheduledAt := time.Now().UnixNano()
+	var mu sync.Mutex
+	workers := workerpool.New(markConcurrency)
+	for _, ev := range batch {
+		accountID := ev.AccountID
+		workers.Submit(func() {
+			if err := storage.SetSubscriberLastScheduled(accountID, scheduledAt); err != nil {
+				getHub(sentry.CurrentHub(), E{"error": err.Error(), "accountId": accountID}).CaptureException(fmt.Errorf("SetSubscriberLastScheduled failed"))
+				log.Printf("ERROR: could not update last scheduled accountId=%s error=%v", accountID, err)
+
+				mu.Lock()
+				result.MarkFailed++
+				mu.Unlock()
 			}
-		}(subscriber.AccountID)
+		})
+	}
+	workers.StopWait()
+}
+
+// decide looks at the activity of the subscriber to find out whether they are due for a refresh
+func decide(now time.Time, subscriber *storage.Subscriber) schedule.Decision {
+	activity := schedule.Activity{
+		Realm:          subscriber.Realm,
+		LastBattleTime: subscriber.LastBattleTime,
+		LastScheduled:  subscriber.LastScheduled,
+	}
+
+	decision := schedule.Decide(now, activity)
+	if !schedule.NeedsEventHistory(now, activity, decision) {
+		return decision
+	}
+
+	earned, err := events.LatestResourceEarned(subscriber.AccountID)
+	if err != nil {
+		log.Printf("WARN: could not get latest ResourceEarned event accountId=%s error=%v", subscriber.AccountID, err)
+		return decision
+	}
+	if earned == nil {
+		return decision
+	}
 
-		if len(batch) >= 100 {
-			log.Printf("Sending batch of size=%d", len(batch))
+	activity.LastEarned = earned.Timestamp
+	return schedule.Decide(now, activity)
+}
 
-			if err := storage.TriggerRefresh(batch); err != nil {
-				sentry.CaptureException(fmt.Errorf("TriggerRefresh failed"))
-				log.Printf("ERROR: sending batch error=%v", err)
+// scheduleRetries sends the refreshes that failed before and are due to be tried again and returns how many were sent
+func scheduleRetries(result *Result) int {
+	retries, err := storage.FindDueRetries(time.Now().UnixNano())
+	if err != nil {
+		getHub(sentry.CurrentHub(), E{"error": err.Error()}).CaptureMessage("FindDueRetries failed")
+		log.Printf("ERROR: could not find due retries error=%v", err)
+		result.Errors = append(result.Errors, fmt.Sprintf("find retries: %v", err))
+		return 0
+	}
+
+	if len(retries) == 0 {
+		return 0
+	}
+	log.Printf("Found due retries count=%d", len(retries))
+
+	var batch []storage.RefreshEvent
+	for _, retry := range retries {
+		subscriber, err := storage.GetSubscriber(retry.AccountID)
+		if err != nil {
+			log.Printf("ERROR: could not get subscriber for retry accountId=%s error=%v", retry.AccountID, err)
+			continue
+		}
+
+		if !subscriber.Active {
+			log.Printf("Dropping retry of inactive subscriber accountId=%s", retry.AccountID)
+			if err := storage.DeleteRetry(retry.AccountID); err != nil {
+				log.Printf("ERROR: could not delete retry accountId=%s error=%v", retry.AccountID, err)
 			}
-			batch = []storage.RefreshEvent{}
+			continue
 		}
+
+		log.Printf("Retrying refresh accountId=%s attempt=%d reason=%s", retry.AccountID, retry.Attempt, retry.Reason)
+		batch = append(batch, retry.RetryEvent(subscriber))
 	}
 
-	if len(batch) == 0 {
-		log.Printf("Skipping last batch, no items")
-		return "done", nil
+	sent := 0
+	for start := 0; start < len(batch); start += batchSize {
+		end := start + batchSize
+		if end > len(batch) {
+			end = len(batch)
+		}
+
+		if err := storage.TriggerRefresh(batch[start:end]); err != nil {
+			sentry.CaptureException(fmt.Errorf("TriggerRefresh failed"))
+			log.Printf("ERROR: sending retry batch error=%v", err)
+			result.Errors = append(result.Errors, fmt.Sprintf("publish retries: %v", err))
+			continue
+		}
+		sent += end - start
+
+		// The retries are only removed once they were sent, a failed refresh stores them again
+		for _, ev := range batch[start:end] {
+			if err := storage.DeleteRetry(ev.AccountID); err != nil {
+				log.Printf("ERROR: could not delete retry accountId=%s error=%v", ev.AccountID, err)
+			}
+		}
 	}
+	return sent
+}
 
-	// send last batch
-	log.Printf("Sending last batch of size=%d", len(batch))
+// redeliverer sends every failed webhook delivery once per run, the backoff between the runs is kept in the log
+var redeliverer = &webhooks.Dispatcher{
+	Client: &http.Client{Timeout: 5 * time.Second},
+	Retry:  notify.Retry{Attempts: 1},
+}
 
-	if err := storage.TriggerRefresh(batch); err != nil {
-		sentry.CaptureException(fmt.Errorf("TriggerRefresh failed"))
-		log.Printf("ERROR: sending batch error=%v", err)
+// redeliverWebhooks sends the failed webhook deliveries that are due again and returns how many were sent
+func redeliverWebhooks(ctx context.Context, result *Result) int {
+	due, err := webhooks.FindDueRedeliveries(time.Now().UnixNano())
+	if err != nil {
+		getHub(sentry.CurrentHub(), E{"error": err.Error()}).CaptureMessage("FindDueRedeliveries failed")
+		log.Printf("ERROR: could not find due webhook redeliveries error=%v", err)
+		result.Errors = append(result.Errors, fmt.Sprintf("find redeliveries: %v", err))
+		return 0
 	}
 
-	log.Printf("Waiting for subscriber schedule data update")
-	wg.Wait()
-	log.Printf("All subscriber scheduling info updated")
+	sent := 0
+	for _, entry := range due {
+		if ctx.Err() != nil {
+			break
+		}
 
-	return "done", nil
+		if err := redeliverer.Redeliver(ctx, entry); err != nil {
+			log.Printf("ERROR: could not redeliver webhook endpointId=%s deliveryId=%s error=%v", entry.EndpointID, entry.DeliveryID, err)
+			continue
+		}
+		sent++
+	}
+	if sent > 0 {
+		log.Printf("Redelivered webhooks due=%d sent=%d", len(due), sent)
+	}
+	return sent
 }
 
 func main() {
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/digest"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+// Result is what the function did
+type Result struct {
+	Subscriptions int
+	Sent          int
+	// Skipped are subscriptions that were due, but had no ships left to play
+	Skipped int
+	Failed  int
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+func Handler(ctx context.Context) (*Result, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	if err := catalogue.Default.Load(); err != nil {
+		log.Printf("WARN: could not load ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+	}
+
+	subscriptions, err := digest.ListSubscriptions()
+	if err != nil {
+		getHub(sentry.CurrentHub(), E{"error": err.Error()}).CaptureMessage("ListSubscriptions failed")
+		return nil, err
+	}
+
+	offsets := digest.OffsetsFromEnv()
+	sender := digest.NewSenderFromEnv()
+	unsubscribeBase := os.Getenv("UNSUBSCRIBE_URL")
+	secret := os.Getenv("SIGNING_SECRET")
+
+	result := &Result{Subscriptions: len(subscriptions)}
+	now := time.Now()
+	for _, subscription := range subscriptions {
+		if ctx.Err() != nil {
+			break
+		}
+
+		sent, err := send(now, offsets, sender, unsubscribeBase, secret, subscription)
+		if err != nil {
+			getHub(sentry.CurrentHub(), E{"error": err.Error(), "accountId": subscription.AccountID}).CaptureMessage("Could not send digest")
+			log.Printf("ERROR: could not send digest accountId=%s error=%v", subscription.AccountID, err)
+			result.Failed++
+			continue
+		}
+		switch sent {
+		case sentDigest:
+			result.Sent++
+		case skippedDigest:
+			result.Skipped++
+		}
+	}
+
+	log.Printf("Digests done subscriptions=%d sent=%d skipped=%d failed=%d", result.Subscriptions, result.Sent, result.Skipped, result.Failed)
+	return result, nil
+}
+
+const (
+	notDue = iota
+	sentDigest
+	skippedDigest
+)
+
+func send(now time.Time, offsets []time.Duration, sender digest.Sender, unsubscribeBase, secret string, subscription *digest.Subscription) (int, error) {
+	subscriber, err := storage.GetSubscriber(subscription.AccountID)
+	if err != nil {
+		return notDue, err
+	}
+
+	end, ok := wows.EventEndTime[subscriber.Realm]
+	if !ok {
+		return notDue, fmt.Errorf("no event end for realm %s", subscriber.Realm)
+	}
+	eventEnd := time.Unix(int64(end), 0)
+
+	due := digest.DueOffsets(now, eventEnd, offsets, subscription.Sent)
+	if len(due) == 0 {
+		return notDue, nil
+	}
+
+	data, err := storage.LoadPublicSubscriberData(subscriber.DataURL)
+	if err != nil {
+		return notDue, err
+	}
+
+	d := digest.Compute(data, eventEnd)
+	status := skippedDigest
+	if !d.Empty() {
+		unsubscribeURL := digest.UnsubscribeURL(unsubscribeBase, secret, subscription.AccountID)
+		subject, body, err := digest.Render(d, unsubscribeURL)
+		if err != nil {
+			return notDue, err
+		}
+
+		if err := sender.Send(digest.Email{
+			To:             subscription.Email,
+			Subject:        subject,
+			Body:           body,
+			UnsubscribeURL: unsubscribeURL,
+		}); err != nil {
+			return notDue, err
+		}
+		log.Printf("Sent digest accountId=%s offsets=%v ships=%d", subscription.AccountID, due, len(d.Ships))
+		status = sentDigest
+	}
+
+	return status, digest.MarkSent(subscription.AccountID, due)
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "sendDigests",
+	})
+
+	lambda.Start(Handler)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"rukenshia/frenchwhaling/pkg/queue"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+// The function does not run as part of a refresh, so deliveries get more time and attempts
+var dispatcher = &notify.Dispatcher{
+	Client: &http.Client{Timeout: 10 * time.Second},
+	Retry:  notify.Retry{Attempts: 5, Backoff: 2 * time.Second},
+}
+
+// Handler delivers the notifications published by the refresh. Deliveries that still fail with a rate limit or
+// server error make the invocation fail, so that it is retried by Lambda.
+func Handler(ctx context.Context, event json.RawMessage) error {
+	defer sentry.Flush(5 * time.Second)
+
+	bodies, err := queue.ParseLambdaEvent(event)
+	if err != nil {
+		sentry.CaptureException(fmt.Errorf("Could not parse event: %v", err))
+		return fmt.Errorf("Could not parse event: %v", err)
+	}
+
+	if err := catalogue.Default.Load(); err != nil {
+		log.Printf("WARN: could not load ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+	}
+
+	var retry []string
+	for _, body := range bodies {
+		var batch notify.Batch
+		if err := json.Unmarshal(body, &batch); err != nil {
+			sentry.CaptureException(fmt.Errorf("Could not parse notification: %v", err))
+			log.Printf("ERROR: could not parse notification body=%s error=%v", body, err)
+			continue
+		}
+
+		// The registration is read again, it may have been changed or removed since the refresh
+		registration, err := notify.GetRegistration(batch.AccountID)
+		if err != nil {
+			log.Printf("ERROR: could not get notification registration accountId=%s error=%v", batch.AccountID, err)
+			retry = append(retry, batch.AccountID)
+			continue
+		}
+		if registration == nil {
+			continue
+		}
+
+		delivery, err := dispatcher.Dispatch(ctx, registration, batch)
+		if err != nil {
+			getHub(sentry.CurrentHub(), E{"error": err.Error(), "accountId": batch.AccountID, "kind": registration.Kind}).CaptureMessage("Could not send notification")
+			log.Printf("WARN: could not send notification accountId=%s error=%v", batch.AccountID, err)
+			if delivery != nil && delivery.Retryable() {
+				retry = append(retry, batch.AccountID)
+			}
+			continue
+		}
+		if delivery != nil {
+			log.Printf("Sent notification accountId=%s kind=%s attempts=%d status=%d", batch.AccountID, registration.Kind, delivery.Attempts, delivery.StatusCode)
+		}
+	}
+
+	if len(retry) > 0 {
+		return fmt.Errorf("Could not send notifications of %d accounts: %v", len(retry), retry)
+	}
+	return nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "sendNotifications",
+	})
+
+	lambda.Start(Handler)
+}
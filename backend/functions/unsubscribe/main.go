@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/digest"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	awsEvents "github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+func textResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       body,
+		Headers: map[string]string{
+			"Content-Type": "text/plain",
+		},
+	}
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// It is linked from the digest emails, the token in the link replaces the login.
+func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.QueryStringParameters["accountId"]
+	log.Printf("Unsubscribe start accountId=%s", accountID)
+
+	if !digest.VerifyUnsubscribeToken(os.Getenv("SIGNING_SECRET"), accountID, request.QueryStringParameters["token"]) {
+		return textResponse(401, "This unsubscribe link is not valid"), nil
+	}
+
+	if err := digest.Unsubscribe(accountID); err != nil {
+		sentry.CaptureException(err)
+		log.Printf("ERROR: could not unsubscribe accountId=%s error=%v", accountID, err)
+		return textResponse(500, "Sorry, we could not unsubscribe you. Please try again later."), nil
+	}
+
+	return textResponse(200, "You will not receive any more digest emails."), nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "unsubscribe",
+	})
+
+	lambda.Start(Handler)
+}
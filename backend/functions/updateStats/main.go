@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/stats"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/getsentry/sentry-go"
+
+	lambdaevents "github.com/aws/aws-lambda-go/events"
+)
+
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+// Handler updates the statistics counters with the events that were added to the events table. Records that can
+// not be read are skipped, the next reconciliation corrects the counters.
+//
+// The counters are added one at a time, so a failed update is not returned: retrying the batch would add the
+// counters that were updated before the error again. The next reconciliation corrects the missing ones.
+func Handler(ctx context.Context, request lambdaevents.DynamoDBEvent) error {
+	defer sentry.Flush(5 * time.Second)
+
+	if err := catalogue.Default.EnsureFresh(); err != nil {
+		log.Printf("WARN: could not update ship catalogue, using known ships count=%d error=%v", catalogue.Default.Len(), err)
+	}
+
+	counters := stats.Counters{}
+	counted := 0
+	for _, record := range request.Records {
+		if record.EventName != "INSERT" {
+			continue
+		}
+
+		event, err := unmarshalRecord(record)
+		if err != nil {
+			getHub(sentry.CurrentHub(), E{"error": err.Error(), "eventId": record.EventID}).CaptureMessage("Could not read event")
+			log.Printf("ERROR: could not read event eventId=%s error=%v", record.EventID, err)
+			continue
+		}
+
+		if counters.AddEvent(event) {
+			counted++
+		}
+	}
+
+	log.Printf("Updating statistics records=%d counted=%d counters=%d", len(request.Records), counted, len(counters))
+	if err := counters.Update(ctx); err != nil {
+		getHub(sentry.CurrentHub(), E{"error": err.Error(), "counters": len(counters)}).CaptureMessage("Could not update statistics")
+		log.Printf("ERROR: could not update statistics, counters are corrected by the next reconciliation counters=%d error=%v", len(counters), err)
+	}
+	return nil
+}
+
+// unmarshalRecord decodes the new image of a stream record. The attribute values of the stream have the same JSON
+// representation as the ones of the SDK.
+func unmarshalRecord(record lambdaevents.DynamoDBEventRecord) (interface{}, error) {
+	raw, err := json.Marshal(record.Change.NewImage)
+	if err != nil {
+		return nil, err
+	}
+
+	var item map[string]*dynamodb.AttributeValue
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return nil, err
+	}
+	return events.Unmarshal(item)
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "updateStats",
+	})
+
+	lambda.Start(Handler)
+}
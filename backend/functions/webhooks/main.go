@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/auth"
+	"rukenshia/frenchwhaling/pkg/webhooks"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/aws/aws-lambda-go/events"
+	awsEvents "github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// Response is of type APIGatewayProxyResponse since we're leveraging the
+// AWS Lambda Proxy Request functionality (default behavior)
+//
+// https://serverless.com/framework/docs/providers/aws/events/apigateway/#lambda-proxy-integration
+type Response events.APIGatewayProxyResponse
+
+type E map[string]interface{}
+
+// deliveryLogLimit is the number of deliveries returned for an endpoint
+const deliveryLogLimit = 50
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
+
+func textResponse(statusCode int, body string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       body,
+		Headers: map[string]string{
+			"Content-Type":                "text/plain",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+func jsonResponse(statusCode int, v interface{}) Response {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return textResponse(500, "Could not encode response")
+	}
+	return Response{
+		StatusCode: statusCode,
+		Body:       string(data),
+		Headers: map[string]string{
+			"Content-Type":                "application/json",
+			"Access-Control-Allow-Origin": "*",
+		},
+	}
+}
+
+// Handler is the lambda handler invoked by the `lambda.Start` function call
+//
+// GET lists the endpoints of the subscriber, POST registers a new endpoint and returns its secret. With an
+// endpointId, GET returns the recent deliveries to the endpoint and DELETE removes it.
+func Handler(ctx context.Context, request awsEvents.APIGatewayProxyRequest) (Response, error) {
+	defer sentry.Flush(5 * time.Second)
+
+	accountID := request.PathParameters["accountId"]
+	endpointID := request.PathParameters["endpointId"]
+	log.Printf("Webhooks start accountId=%s endpointId=%s method=%s", accountID, endpointID, request.HTTPMethod)
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", accountID)
+		scope.SetLevel(sentry.LevelError)
+	})
+
+	authz, ok := request.Headers["authorization"]
+	if !ok {
+		authz, ok = request.Headers["Authorization"]
+
+		if !ok {
+			return textResponse(401, "No authorization passed"), nil
+		}
+	}
+
+	if err := auth.VerifyToken(authz, accountID); err != nil {
+		getHub(sentryAccountHub, E{"token": authz}).CaptureException(err)
+		return textResponse(401, "Unauthorized"), nil
+	}
+
+	endpoints, err := webhooks.GetEndpoints(accountID)
+	if err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetEndpoints failed")
+		log.Printf("ERROR: could not get webhook endpoints accountId=%s error=%v", accountID, err)
+		return textResponse(500, "Could not get webhooks"), nil
+	}
+	// The secret is only returned when the endpoint is created
+	for _, endpoint := range endpoints {
+		endpoint.Secret = ""
+	}
+
+	if endpointID == "" {
+		switch request.HTTPMethod {
+		case "GET":
+			return jsonResponse(200, endpoints), nil
+
+		case "POST":
+			var body struct {
+				URL        string
+				EventTypes []string
+			}
+			if err := json.Unmarshal([]byte(request.Body), &body); err != nil {
+				return textResponse(400, "Invalid body"), nil
+			}
+
+			if err := (&webhooks.Endpoint{URL: body.URL, EventTypes: body.EventTypes}).Validate(); err != nil {
+				return textResponse(400, err.Error()), nil
+			}
+			if len(endpoints) >= webhooks.MaxEndpoints {
+				return textResponse(400, webhooks.ErrTooManyEndpoints.Error()), nil
+			}
+
+			endpoint, err := webhooks.CreateEndpoint(accountID, body.URL, body.EventTypes)
+			if err != nil {
+				getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("CreateEndpoint failed")
+				log.Printf("ERROR: could not create webhook endpoint accountId=%s error=%v", accountID, err)
+				return textResponse(500, "Could not create webhook"), nil
+			}
+			log.Printf("Registered webhook endpoint accountId=%s endpointId=%s", accountID, endpoint.EndpointID)
+			return jsonResponse(201, endpoint), nil
+		}
+		return textResponse(405, "Method not allowed"), nil
+	}
+
+	// Endpoints of other subscribers are not found, even if the ID exists
+	found := false
+	for _, endpoint := range endpoints {
+		found = found || endpoint.EndpointID == endpointID
+	}
+	if !found {
+		return textResponse(404, "Not found"), nil
+	}
+
+	switch request.HTTPMethod {
+	case "GET":
+		logs, err := webhooks.GetDeliveryLogs(endpointID, deliveryLogLimit)
+		if err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetDeliveryLogs failed")
+			log.Printf("ERROR: could not get webhook deliveries endpointId=%s error=%v", endpointID, err)
+			return textResponse(500, "Could not get deliveries"), nil
+		}
+		return jsonResponse(200, logs), nil
+
+	case "DELETE":
+		if err := webhooks.DeleteEndpoint(accountID, endpointID); err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("DeleteEndpoint failed")
+			log.Printf("ERROR: could not delete webhook endpoint endpointId=%s error=%v", endpointID, err)
+			return textResponse(500, "Could not delete webhook"), nil
+		}
+		return textResponse(200, "OK"), nil
+	}
+
+	return textResponse(405, "Method not allowed"), nil
+}
+
+func main() {
+	sentry.Init(sentry.ClientOptions{
+		Dsn:        os.Getenv("SENTRY_DSN"),
+		ServerName: "webhooks",
+	})
+
+	lambda.Start(Handler)
+}
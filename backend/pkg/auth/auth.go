@@ -2,23 +2,40 @@ package auth
 
 import (
 	"errors"
+	"os"
 	"strings"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
 var (
-	ErrCouldNotParse  = errors.New("could not parse jwt")
-	ErrInvalidSubject = errors.New("user is not authorized for this account id")
+	ErrCouldNotParse     = errors.New("could not parse jwt")
+	ErrInvalidSignature  = errors.New("jwt is not signed by whaling")
+	ErrInvalidSubject    = errors.New("user is not authorized for this account id")
+	ErrNoSigningSecret   = errors.New("SIGNING_SECRET is not set")
+	ErrUnexpectedSigning = errors.New("unexpected signing method")
 )
 
+// VerifyToken checks that the token was signed with SIGNING_SECRET by the login function and belongs to the
+// given account
 func VerifyToken(token, accountId string) error {
-	parser := jwt.Parser{}
+	secret := os.Getenv("SIGNING_SECRET")
+	if secret == "" {
+		return ErrNoSigningSecret
+	}
 
 	claims := jwt.MapClaims{}
-	_, _, err := parser.ParseUnverified(strings.Replace(token, "Bearer ", "", 1), &claims)
+	_, err := jwt.ParseWithClaims(strings.Replace(token, "Bearer ", "", 1), &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrUnexpectedSigning
+		}
+		return []byte(secret), nil
+	})
 	if err != nil {
-		return ErrCouldNotParse
+		if verr, ok := err.(*jwt.ValidationError); ok && verr.Errors&jwt.ValidationErrorMalformed != 0 {
+			return ErrCouldNotParse
+		}
+		return ErrInvalidSignature
 	}
 
 	// A valid JWT is supplied, but for another account
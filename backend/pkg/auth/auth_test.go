@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, sub string) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(method, jwt.MapClaims{
+		"iss": "whaling",
+		"exp": "1600000000",
+		"sub": sub,
+	}).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestVerifyToken(t *testing.T) {
+	os.Setenv("SIGNING_SECRET", "secret")
+	defer os.Unsetenv("SIGNING_SECRET")
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"valid", sign(t, jwt.SigningMethodHS256, []byte("secret"), "500"), nil},
+		{"bearer", "Bearer " + sign(t, jwt.SigningMethodHS256, []byte("secret"), "500"), nil},
+		{"other account", sign(t, jwt.SigningMethodHS256, []byte("secret"), "501"), ErrInvalidSubject},
+		{"forged", sign(t, jwt.SigningMethodHS256, []byte("forged"), "500"), ErrInvalidSignature},
+		{"unsigned", sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "500"), ErrInvalidSignature},
+		{"garbage", "not-a-token", ErrCouldNotParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyToken(tt.token, "500"); err != tt.err {
+				t.Errorf("VerifyToken() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenWithoutSecret(t *testing.T) {
+	os.Unsetenv("SIGNING_SECRET")
+
+	// Without a secret, a token signed with an empty key would otherwise be accepted
+	if err := VerifyToken(sign(t, jwt.SigningMethodHS256, []byte(""), "500"), "500"); err != ErrNoSigningSecret {
+		t.Errorf("VerifyToken() error = %v, want %v", err, ErrNoSigningSecret)
+	}
+}
@@ -0,0 +1,130 @@
+// Package digest sends subscribers an email with the ships they still have to play before the event ends.
+package digest
+
+import (
+	"bytes"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"sort"
+	"text/template"
+	"time"
+)
+
+// RemainingShip is a ship in port that has not earned its resource yet
+type RemainingShip struct {
+	ShipID   int64
+	Name     string
+	Tier     int
+	Type     wows.ShipType
+	Resource wows.Resource
+	Amount   uint
+}
+
+// RemainingResource is the amount of a resource that can still be earned
+type RemainingResource struct {
+	Resource wows.Resource
+	Amount   uint
+	Earned   uint
+}
+
+// Digest is the content of a digest email
+type Digest struct {
+	AccountID string
+	EventEnd  time.Time
+	Ships     []RemainingShip
+	Resources []RemainingResource
+}
+
+// Compute collects the ships in port that did not earn their resource yet, highest tier first
+func Compute(data *storage.SubscriberPublicData, eventEnd time.Time) *Digest {
+	d := &Digest{
+		AccountID: data.AccountID,
+		EventEnd:  eventEnd,
+	}
+
+	remaining := map[wows.Resource]uint{}
+	for _, ship := range data.Ships {
+		if ship.ShipStatistics == nil || ship.Private == nil || !ship.Private.InGarage || ship.Resource.Earned > 0 {
+			continue
+		}
+
+		r := RemainingShip{
+			ShipID:   ship.ShipID,
+			Name:     "Unknown ship",
+			Resource: ship.Resource.Type,
+			Amount:   ship.Resource.Amount,
+		}
+		if warship, ok := catalogue.Default.Get(ship.ShipID); ok {
+			r.Name = warship.Name
+			r.Tier = warship.Tier
+			r.Type = warship.Type
+		}
+		d.Ships = append(d.Ships, r)
+		remaining[ship.Resource.Type] += ship.Resource.Amount
+	}
+
+	sort.Slice(d.Ships, func(i, j int) bool {
+		if d.Ships[i].Tier != d.Ships[j].Tier {
+			return d.Ships[i].Tier > d.Ships[j].Tier
+		}
+		return d.Ships[i].Name < d.Ships[j].Name
+	})
+
+	for _, resource := range wows.Resources {
+		if remaining[resource] == 0 {
+			continue
+		}
+		d.Resources = append(d.Resources, RemainingResource{
+			Resource: resource,
+			Amount:   remaining[resource],
+			Earned:   data.Resources.Get(resource).Earned,
+		})
+	}
+	return d
+}
+
+// Empty returns whether there is nothing left to earn
+func (d *Digest) Empty() bool {
+	return len(d.Ships) == 0
+}
+
+// Subject is the subject of the digest email
+var Subject = template.Must(template.New("subject").Parse(
+	`{{ len .Digest.Ships }} ships left to play before the event ends`))
+
+// Body is the text of the digest email
+var Body = template.Must(template.New("body").Funcs(template.FuncMap{
+	"resource": func(r wows.Resource) string { return r.Name() },
+	"date":     func(t time.Time) string { return t.UTC().Format("Monday, January 2 15:04 MST") },
+}).Parse(`Hi,
+
+the event ends on {{ date .Digest.EventEnd }}. You can still earn:
+
+{{ range .Digest.Resources }}  {{ .Amount }}x {{ resource .Resource }} (earned so far: {{ .Earned }})
+{{ end }}
+by winning a battle with these ships in your port:
+
+{{ range .Digest.Ships }}  {{ if .Tier }}Tier {{ .Tier }} {{ end }}{{ .Name }} - {{ .Amount }}x {{ resource .Resource }}
+{{ end }}
+Your progress: https://whaling.in.fkn.space
+
+You get this email because you signed up for the digest. Unsubscribe: {{ .UnsubscribeURL }}
+`))
+
+// Render returns the subject and body of the email
+func Render(d *Digest, unsubscribeURL string) (string, string, error) {
+	data := struct {
+		Digest         *Digest
+		UnsubscribeURL string
+	}{d, unsubscribeURL}
+
+	var subject, body bytes.Buffer
+	if err := Subject.Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := Body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return subject.String(), body.String(), nil
+}
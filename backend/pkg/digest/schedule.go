@@ -0,0 +1,53 @@
+package digest
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultOffsets are the times before the end of the event at which the digest is sent
+var DefaultOffsets = []time.Duration{7 * 24 * time.Hour, 24 * time.Hour}
+
+// OffsetsFromEnv reads the offsets from DIGEST_OFFSETS, a comma separated list of durations such as "168h,24h"
+func OffsetsFromEnv() []time.Duration {
+	value := os.Getenv("DIGEST_OFFSETS")
+	if value == "" {
+		return DefaultOffsets
+	}
+
+	var offsets []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		offset, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil || offset <= 0 {
+			continue
+		}
+		offsets = append(offsets, offset)
+	}
+	if len(offsets) == 0 {
+		return DefaultOffsets
+	}
+	return offsets
+}
+
+// DueOffsets returns the offsets that have been reached and were not sent yet. If several are due at once,
+// for example for a subscription created late, only one digest should be sent for all of them.
+func DueOffsets(now, eventEnd time.Time, offsets []time.Duration, sent []string) []string {
+	if !now.Before(eventEnd) {
+		return nil
+	}
+
+	done := map[string]bool{}
+	for _, s := range sent {
+		done[s] = true
+	}
+
+	var due []string
+	for _, offset := range offsets {
+		if now.Before(eventEnd.Add(-offset)) || done[offset.String()] {
+			continue
+		}
+		due = append(due, offset.String())
+	}
+	return due
+}
@@ -0,0 +1,110 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Email is a plain text email
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+	// UnsubscribeURL is sent in the List-Unsubscribe header, so that mail clients can offer a one-click unsubscribe
+	UnsubscribeURL string
+}
+
+// Sender sends an email
+type Sender interface {
+	Send(email Email) error
+}
+
+// SMTPSender sends emails through an SMTP server
+type SMTPSender struct {
+	// Addr is the host:port of the server
+	Addr     string
+	Username string
+	Password string
+	From     string
+}
+
+// Send sends a plain text email
+func (s *SMTPSender) Send(email Email) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Addr)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	return smtp.SendMail(s.Addr, auth, s.From, []string{email.To}, message(s.From, email))
+}
+
+// FileSender writes the emails into a directory instead of sending them, as a stand-in for SMTP when
+// running locally. Without a directory the emails are logged.
+type FileSender struct {
+	Dir  string
+	From string
+}
+
+// Send writes the email as .eml file
+func (s *FileSender) Send(email Email) error {
+	msg := message(s.From, email)
+	if s.Dir == "" {
+		log.Printf("FileSender: email to=%s\n%s", email.To, msg)
+		return nil
+	}
+
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.Replace(email.To, "@", "_at_", -1))
+	return ioutil.WriteFile(filepath.Join(s.Dir, filepath.Base(name)), msg, 0644)
+}
+
+// NewSenderFromEnv creates the sender configured in the environment. DIGEST_SENDER selects smtp, which uses
+// SMTP_ADDR, SMTP_USERNAME and SMTP_PASSWORD, or file (default), which writes to DIGEST_DIR.
+// The sender address is taken from DIGEST_FROM.
+func NewSenderFromEnv() Sender {
+	from := os.Getenv("DIGEST_FROM")
+	if from == "" {
+		from = "whaling@in.fkn.space"
+	}
+
+	if os.Getenv("DIGEST_SENDER") == "smtp" {
+		return &SMTPSender{
+			Addr:     os.Getenv("SMTP_ADDR"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}
+	}
+	return &FileSender{Dir: os.Getenv("DIGEST_DIR"), From: from}
+}
+
+func message(from string, email Email) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", email.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", strings.Replace(email.Subject, "\n", " ", -1))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	if email.UnsubscribeURL != "" {
+		fmt.Fprintf(&buf, "List-Unsubscribe: <%s>\r\n", email.UnsubscribeURL)
+		buf.WriteString("List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n")
+	}
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.Replace(email.Body, "\n", "\r\n", -1))
+	return buf.Bytes()
+}
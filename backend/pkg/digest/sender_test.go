@@ -0,0 +1,24 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMessageUnsubscribeHeaders(t *testing.T) {
+	msg := string(message("whaling@in.fkn.space", Email{
+		To:             "player@example.com",
+		Subject:        "Your digest",
+		Body:           "Hello\n",
+		UnsubscribeURL: "https://example.com/digest/unsubscribe?accountId=500&token=abc",
+	}))
+
+	for _, header := range []string{
+		"List-Unsubscribe: <https://example.com/digest/unsubscribe?accountId=500&token=abc>\r\n",
+		"List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n",
+	} {
+		if !strings.Contains(msg, header) {
+			t.Errorf("message is missing %q:\n%s", header, msg)
+		}
+	}
+}
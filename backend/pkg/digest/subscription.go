@@ -0,0 +1,132 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ErrInvalidEmail is returned for subscriptions without a valid email address
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// Subscription is a subscriber that wants to receive the digest
+type Subscription struct {
+	AccountID string
+	Email     string
+	// Sent holds the offsets the digest was already sent for. It is left out while empty, DynamoDB would store
+	// an empty list as NULL, which list_append can not append to.
+	Sent      []string `dynamodbav:",omitempty"`
+	CreatedAt int64
+}
+
+// Subscribe creates or replaces the subscription of an account
+func Subscribe(accountID, email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return ErrInvalidEmail
+	}
+
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	av, err := subscriptionItem(accountID, address.Address, time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String("whaling-digest"),
+		Item:      av,
+	})
+	return err
+}
+
+func subscriptionItem(accountID, email string, now time.Time) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(Subscription{
+		AccountID: accountID,
+		Email:     email,
+		CreatedAt: now.UnixNano(),
+	})
+}
+
+// Unsubscribe removes the subscription of an account
+func Unsubscribe(accountID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String("whaling-digest"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+	})
+	return err
+}
+
+// ListSubscriptions returns all subscriptions
+func ListSubscriptions() ([]*Subscription, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	var subscriptions []*Subscription
+	var pageErr error
+	err := svc.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String("whaling-digest"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var items []*Subscription
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			pageErr = fmt.Errorf("Failed to unmarshal Record: %v", err)
+			return false
+		}
+		subscriptions = append(subscriptions, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, pageErr
+}
+
+// MarkSent records that the digest for the offsets was sent
+func MarkSent(accountID string, offsets []string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.UpdateItem(markSentInput(accountID, offsets))
+	return err
+}
+
+func markSentInput(accountID string, offsets []string) *dynamodb.UpdateItemInput {
+	values := make([]*dynamodb.AttributeValue, len(offsets))
+	for i, offset := range offsets {
+		values[i] = &dynamodb.AttributeValue{S: aws.String(offset)}
+	}
+
+	return &dynamodb.UpdateItemInput{
+		TableName: aws.String("whaling-digest"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":o": {
+				L: values,
+			},
+			":empty": {
+				L: []*dynamodb.AttributeValue{},
+			},
+		},
+		// Only update subscriptions that were not removed in the meantime
+		ConditionExpression: aws.String("attribute_exists(AccountID)"),
+		UpdateExpression:    aws.String("set Sent = list_append(if_not_exists(Sent, :empty), :o)"),
+	}
+}
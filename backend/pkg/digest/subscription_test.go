@@ -0,0 +1,54 @@
+package digest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// applyMarkSent applies the update of markSentInput to a stored item the way DynamoDB evaluates
+// list_append(if_not_exists(Sent, :empty), :o): an attribute that is not a list makes the update fail
+func applyMarkSent(t *testing.T, item map[string]*dynamodb.AttributeValue, input *dynamodb.UpdateItemInput) {
+	t.Helper()
+
+	if expr := aws.StringValue(input.UpdateExpression); expr != "set Sent = list_append(if_not_exists(Sent, :empty), :o)" {
+		t.Fatalf("unexpected update expression %q", expr)
+	}
+
+	sent, ok := item["Sent"]
+	if !ok {
+		sent = input.ExpressionAttributeValues[":empty"]
+	}
+	if sent == nil || sent.L == nil {
+		t.Fatalf("ValidationException: Sent is not a list: %v", sent)
+	}
+	item["Sent"] = &dynamodb.AttributeValue{L: append(append([]*dynamodb.AttributeValue{}, sent.L...), input.ExpressionAttributeValues[":o"].L...)}
+}
+
+func TestSubscribeThenMarkSent(t *testing.T) {
+	item, err := subscriptionItem("500", "player@example.com", time.Unix(1600000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent, ok := item["Sent"]; ok && aws.BoolValue(sent.NULL) {
+		t.Fatal("a new subscription must not store Sent as NULL")
+	}
+
+	applyMarkSent(t, item, markSentInput("500", []string{"168h0m0s"}))
+	applyMarkSent(t, item, markSentInput("500", []string{"24h0m0s", "1h0m0s"}))
+
+	var subscription Subscription
+	if err := dynamodbattribute.UnmarshalMap(item, &subscription); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"168h0m0s", "24h0m0s", "1h0m0s"}; !reflect.DeepEqual(subscription.Sent, want) {
+		t.Errorf("Sent = %v, want %v", subscription.Sent, want)
+	}
+	if subscription.Email != "player@example.com" {
+		t.Errorf("Email = %s", subscription.Email)
+	}
+}
@@ -0,0 +1,34 @@
+package digest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+)
+
+// UnsubscribeToken signs the account ID, so that the unsubscribe link works without logging in
+func UnsubscribeToken(secret, accountID string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("digest-unsubscribe:" + accountID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyUnsubscribeToken checks a token created by UnsubscribeToken
+func VerifyUnsubscribeToken(secret, accountID, token string) bool {
+	expected, err := hex.DecodeString(UnsubscribeToken(secret, accountID))
+	if err != nil {
+		return false
+	}
+	actual, err := hex.DecodeString(token)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, actual)
+}
+
+// UnsubscribeURL returns the link to the unsubscribe function
+func UnsubscribeURL(base, secret, accountID string) string {
+	return fmt.Sprintf("%s?accountId=%s&token=%s", base, url.QueryEscape(accountID), UnsubscribeToken(secret, accountID))
+}
@@ -14,6 +14,13 @@ type SubscriberEvent struct {
 	AccountID string
 	Timestamp int64
 	Type      string
+	// Realm is not set for events that were stored before it was added
+	Realm string `json:",omitempty"`
+}
+
+// EventType returns the type of the event, it is promoted to all events
+func (e SubscriberEvent) EventType() string {
+	return e.Type
 }
 
 type ResourceEarned struct {
@@ -24,22 +31,48 @@ type ResourceEarned struct {
 	BattleType string
 }
 
+// ResourceRevoked takes back a ResourceEarned event, for example when a ship was unmarked as played
+type ResourceRevoked struct {
+	SubscriberEvent
+	ShipID   int64
+	Resource wows.Resource
+	Amount   uint
+	Reason   string
+}
+
 type ShipAddition struct {
 	SubscriberEvent
-	ShipID int64
+	ShipID   int64
+	Resource wows.Resource
+	Amount   uint
+	// Initial is set for the ships found by the first refresh of a subscriber, they are only used for statistics
+	Initial bool `json:",omitempty"`
 }
 
 type ShipRemoval struct {
 	SubscriberEvent
-	ShipID int64
+	ShipID   int64
+	Resource wows.Resource
+	Amount   uint
+	// Earned is the amount of the resource the ship had earned before it was removed
+	Earned uint
 }
 
-func NewResourceEarned(accountID string, resource wows.Resource, amount uint, shipID int64, battleType string) ResourceEarned {
+// RefreshCompleted is sent to webhooks after the data of a subscriber was refreshed. It is not stored in the events table.
+type RefreshCompleted struct {
+	SubscriberEvent
+	// Earned is the number of ships that earned their resource in this refresh
+	Earned      int
+	LastUpdated int64
+}
+
+func NewResourceEarned(accountID, realm string, resource wows.Resource, amount uint, shipID int64, battleType string) ResourceEarned {
 	return ResourceEarned{
 		SubscriberEvent: SubscriberEvent{
 			AccountID: accountID,
 			Timestamp: time.Now().UnixNano(),
 			Type:      "ResourceEarned",
+			Realm:     realm,
 		},
 		ShipID:     shipID,
 		Amount:     amount,
@@ -48,25 +81,59 @@ func NewResourceEarned(accountID string, resource wows.Resource, amount uint, sh
 	}
 }
 
-func NewShipAddition(accountID string, shipID int64) ShipAddition {
+func NewResourceRevoked(accountID, realm string, resource wows.Resource, amount uint, shipID int64, reason string) ResourceRevoked {
+	return ResourceRevoked{
+		SubscriberEvent: SubscriberEvent{
+			AccountID: accountID,
+			Timestamp: time.Now().UnixNano(),
+			Type:      "ResourceRevoked",
+			Realm:     realm,
+		},
+		ShipID:   shipID,
+		Resource: resource,
+		Amount:   amount,
+		Reason:   reason,
+	}
+}
+
+func NewShipAddition(accountID, realm string, shipID int64, resource wows.Resource, amount uint) ShipAddition {
 	return ShipAddition{
 		SubscriberEvent: SubscriberEvent{
 			AccountID: accountID,
 			Timestamp: time.Now().UnixNano(),
 			Type:      "ShipAddition",
+			Realm:     realm,
 		},
-		ShipID: shipID,
+		ShipID:   shipID,
+		Resource: resource,
+		Amount:   amount,
 	}
 }
 
-func NewShipRemoval(accountID string, shipID int64) ShipAddition {
-	return ShipAddition{
+func NewShipRemoval(accountID, realm string, shipID int64, resource wows.Resource, amount, earned uint) ShipRemoval {
+	return ShipRemoval{
 		SubscriberEvent: SubscriberEvent{
 			AccountID: accountID,
 			Timestamp: time.Now().UnixNano(),
 			Type:      "ShipRemoval",
+			Realm:     realm,
 		},
-		ShipID: shipID,
+		ShipID:   shipID,
+		Resource: resource,
+		Amount:   amount,
+		Earned:   earned,
+	}
+}
+
+func NewRefreshCompleted(accountID string, earned int, lastUpdated int64) RefreshCompleted {
+	return RefreshCompleted{
+		SubscriberEvent: SubscriberEvent{
+			AccountID: accountID,
+			Timestamp: time.Now().UnixNano(),
+			Type:      "RefreshCompleted",
+		},
+		Earned:      earned,
+		LastUpdated: lastUpdated,
 	}
 }
 
@@ -88,3 +155,84 @@ func Add(event interface{}) error {
 
 	return nil
 }
+
+// LatestResourceEarned returns the most recent ResourceEarned event of an account or nil if there is none
+func LatestResourceEarned(accountID string) (*ResourceEarned, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	var latest *ResourceEarned
+	var pageErr error
+	err := svc.QueryPages(&dynamodb.QueryInput{
+		TableName: aws.String("whaling-subscribers-events"),
+		ExpressionAttributeNames: map[string]*string{
+			"#t": aws.String("Type"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":a": {
+				S: aws.String(accountID),
+			},
+			":t": {
+				S: aws.String("ResourceEarned"),
+			},
+		},
+		KeyConditionExpression: aws.String("AccountID = :a"),
+		FilterExpression:       aws.String("#t = :t"),
+		ScanIndexForward:       aws.Bool(false),
+	}, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		if len(page.Items) == 0 {
+			return true
+		}
+
+		var event ResourceEarned
+		if err := dynamodbattribute.UnmarshalMap(page.Items[0], &event); err != nil {
+			pageErr = err
+			return false
+		}
+		latest = &event
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return latest, pageErr
+}
+
+// Unmarshal decodes a stored event into the struct of its type. Events of other types are returned as SubscriberEvent.
+func Unmarshal(item map[string]*dynamodb.AttributeValue) (interface{}, error) {
+	var base SubscriberEvent
+	if err := dynamodbattribute.UnmarshalMap(item, &base); err != nil {
+		return nil, err
+	}
+
+	var event interface{}
+	switch base.Type {
+	case "ResourceEarned":
+		event = &ResourceEarned{}
+	case "ResourceRevoked":
+		event = &ResourceRevoked{}
+	case "ShipAddition":
+		event = &ShipAddition{}
+	case "ShipRemoval":
+		event = &ShipRemoval{}
+	default:
+		return base, nil
+	}
+
+	if err := dynamodbattribute.UnmarshalMap(item, event); err != nil {
+		return nil, err
+	}
+
+	// The event is returned as a value, like the constructors do
+	switch e := event.(type) {
+	case *ResourceEarned:
+		return *e, nil
+	case *ResourceRevoked:
+		return *e, nil
+	case *ShipAddition:
+		return *e, nil
+	case *ShipRemoval:
+		return *e, nil
+	}
+	return event, nil
+}
@@ -0,0 +1,119 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Request is a single HTTP POST to a webhook
+type Request struct {
+	URL     string
+	Body    []byte
+	Headers map[string]string
+}
+
+// Delivery describes how a request was delivered
+type Delivery struct {
+	Attempts   int
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Retryable returns whether a failed delivery may succeed when it is sent again later
+func (d *Delivery) Retryable() bool {
+	return retryable(d.StatusCode)
+}
+
+// Retry configures how often and how fast failed deliveries are retried
+type Retry struct {
+	Attempts int
+	// Backoff is the delay before the second attempt, it doubles with every further attempt
+	Backoff time.Duration
+}
+
+// DefaultRetry is used for deliveries that do not configure retries
+var DefaultRetry = Retry{Attempts: 3, Backoff: time.Second}
+
+// MaxRetryAfter caps how long a receiver can make a delivery wait with Retry-After
+var MaxRetryAfter = 30 * time.Second
+
+// Deliver posts the request and retries on network errors, rate limits and server errors.
+// Client errors are not retried as they will not go away by themselves.
+func Deliver(ctx context.Context, client *http.Client, req Request, retry Retry) (*Delivery, error) {
+	start := time.Now()
+	delivery := &Delivery{}
+	backoff := retry.Backoff
+
+	for {
+		delivery.Attempts++
+		statusCode, wait, err := post(ctx, client, req)
+		delivery.StatusCode = statusCode
+		delivery.Duration = time.Since(start)
+
+		if err == nil {
+			return delivery, nil
+		}
+		if !retryable(statusCode) || delivery.Attempts >= retry.Attempts {
+			return delivery, err
+		}
+
+		// Honour Retry-After on rate limits, as Discord sends it
+		if wait > MaxRetryAfter {
+			wait = MaxRetryAfter
+		}
+		if wait < backoff {
+			wait = backoff
+		}
+		backoff *= 2
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return delivery, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// post sends the request once and returns the status code and how long the receiver asked to wait
+func post(ctx context.Context, client *http.Client, req Request) (int, time.Duration, error) {
+	httpReq, err := http.NewRequest(http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, 0, err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "whaling.in.fkn.space")
+	for name, value := range req.Headers {
+		httpReq.Header.Set(name, value)
+	}
+
+	res, err := client.Do(httpReq)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, 64*1024))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res.StatusCode, 0, nil
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.ParseFloat(res.Header.Get("Retry-After"), 64); err == nil {
+		wait = time.Duration(seconds * float64(time.Second))
+	}
+	return res.StatusCode, wait, fmt.Errorf("webhook returned status %d", res.StatusCode)
+}
+
+// retryable returns whether a request that failed with the status code may succeed later, 0 means no response
+func retryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
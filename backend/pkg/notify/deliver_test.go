@@ -0,0 +1,134 @@
+package notify
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// server responds with the given handlers in order, the last one is repeated. It has to be closed by the caller.
+func server(t *testing.T, handlers ...http.HandlerFunc) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		if n > len(handlers) {
+			n = len(handlers)
+		}
+		handlers[n-1](w, r)
+	}))
+	return srv, &calls
+}
+
+func status(code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+	}
+}
+
+var testRetry = Retry{Attempts: 3, Backoff: time.Millisecond}
+
+func TestDeliverSuccess(t *testing.T) {
+	var body string
+	srv, calls := server(t, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("X-Test") != "yes" {
+			t.Errorf("unexpected request method=%s headers=%v", r.Method, r.Header)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	delivery, err := Deliver(context.Background(), srv.Client(), Request{
+		URL:     srv.URL,
+		Body:    []byte(`{"content":"hi"}`),
+		Headers: map[string]string{"X-Test": "yes"},
+	}, testRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Attempts != 1 || delivery.StatusCode != http.StatusNoContent || *calls != 1 {
+		t.Errorf("delivery = %+v calls=%d, want one attempt with 204", delivery, *calls)
+	}
+	if body != `{"content":"hi"}` {
+		t.Errorf("body = %s", body)
+	}
+}
+
+func TestDeliverRateLimited(t *testing.T) {
+	srv, calls := server(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, status(http.StatusOK))
+	defer srv.Close()
+
+	start := time.Now()
+	delivery, err := Deliver(context.Background(), srv.Client(), Request{URL: srv.URL}, testRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Attempts != 2 || *calls != 2 {
+		t.Errorf("attempts=%d calls=%d, want 2", delivery.Attempts, *calls)
+	}
+	if waited := time.Since(start); waited < time.Second {
+		t.Errorf("waited %s, want at least the Retry-After of 1s", waited)
+	}
+}
+
+func TestDeliverRetryAfterIsCapped(t *testing.T) {
+	defer func(max time.Duration) { MaxRetryAfter = max }(MaxRetryAfter)
+	MaxRetryAfter = 10 * time.Millisecond
+
+	srv, calls := server(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, status(http.StatusOK))
+	defer srv.Close()
+
+	start := time.Now()
+	delivery, err := Deliver(context.Background(), srv.Client(), Request{URL: srv.URL}, testRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Attempts != 2 || *calls != 2 {
+		t.Errorf("attempts=%d calls=%d, want 2", delivery.Attempts, *calls)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.Errorf("waited %s, want at most MaxRetryAfter", waited)
+	}
+}
+
+func TestDeliverServerError(t *testing.T) {
+	srv, calls := server(t, status(http.StatusBadGateway))
+	defer srv.Close()
+
+	delivery, err := Deliver(context.Background(), srv.Client(), Request{URL: srv.URL}, testRetry)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if delivery.Attempts != testRetry.Attempts || *calls != int32(testRetry.Attempts) {
+		t.Errorf("attempts=%d calls=%d, want %d", delivery.Attempts, *calls, testRetry.Attempts)
+	}
+	if !delivery.Retryable() {
+		t.Error("a server error should be retryable later")
+	}
+}
+
+func TestDeliverClientError(t *testing.T) {
+	srv, calls := server(t, status(http.StatusNotFound))
+	defer srv.Close()
+
+	delivery, err := Deliver(context.Background(), srv.Client(), Request{URL: srv.URL}, testRetry)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if delivery.Attempts != 1 || *calls != 1 || delivery.Retryable() {
+		t.Errorf("attempts=%d calls=%d retryable=%v, want a single attempt that is not retried", delivery.Attempts, *calls, delivery.Retryable())
+	}
+}
@@ -0,0 +1,131 @@
+// Package notify sends messages about earned resources and new ships to webhooks registered by subscribers,
+// for example to a Discord channel of their clan.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"text/template"
+	"time"
+)
+
+// discordMaxLength is the maximum length of a Discord message
+const discordMaxLength = 2000
+
+// Batch holds all events of a single refresh, they are sent as one message
+type Batch struct {
+	AccountID      string
+	ResourceEarned []events.ResourceEarned
+	ShipAdditions  []events.ShipAddition
+}
+
+// Empty returns whether there is nothing to send
+func (b *Batch) Empty() bool {
+	return len(b.ResourceEarned) == 0 && len(b.ShipAdditions) == 0
+}
+
+// Filter returns the events of the batch the registration asked for
+func (b *Batch) Filter(r *Registration) Batch {
+	filtered := Batch{AccountID: b.AccountID}
+	for _, e := range b.ResourceEarned {
+		if r.Wants(e.Resource) {
+			filtered.ResourceEarned = append(filtered.ResourceEarned, e)
+		}
+	}
+	if r.ShipAdditions {
+		filtered.ShipAdditions = b.ShipAdditions
+	}
+	return filtered
+}
+
+// shipName returns the name of the ship from the catalogue
+func shipName(shipID int64) string {
+	if ship, ok := catalogue.Default.Get(shipID); ok {
+		return ship.Name
+	}
+	return "an unknown ship"
+}
+
+// MessageTemplate is the text sent for a batch
+var MessageTemplate = template.Must(template.New("message").Funcs(template.FuncMap{
+	"ship":     shipName,
+	"resource": func(r wows.Resource) string { return r.Name() },
+}).Parse(`{{ range .Batch.ResourceEarned -}}
+**{{ $.Name }}** earned {{ .Amount }}x {{ resource .Resource }} with {{ ship .ShipID }}
+{{ end -}}
+{{ range .Batch.ShipAdditions -}}
+**{{ $.Name }}** has a new ship in port: {{ ship .ShipID }}
+{{ end -}}`))
+
+// Dispatcher sends batches to the registered webhooks
+type Dispatcher struct {
+	Client *http.Client
+	Retry  Retry
+}
+
+// NewDispatcher creates a dispatcher with a short timeout, as it runs as part of the refresh
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		Client: &http.Client{Timeout: 5 * time.Second},
+		Retry:  DefaultRetry,
+	}
+}
+
+// Render returns the text of the message for the batch
+func Render(r *Registration, batch Batch) (string, error) {
+	name := r.DisplayName
+	if name == "" {
+		name = r.AccountID
+	}
+
+	var buf bytes.Buffer
+	if err := MessageTemplate.Execute(&buf, struct {
+		Name  string
+		Batch Batch
+	}{name, batch}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Dispatch sends the events of the batch the registration asked for. Nothing is sent if there are none.
+func (d *Dispatcher) Dispatch(ctx context.Context, r *Registration, batch Batch) (*Delivery, error) {
+	batch = batch.Filter(r)
+	if batch.Empty() {
+		return nil, nil
+	}
+
+	text, err := Render(r, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	switch r.Kind {
+	case Discord:
+		if runes := []rune(text); len(runes) > discordMaxLength {
+			text = string(runes[:discordMaxLength-3]) + "..."
+		}
+		body, err = json.Marshal(map[string]string{
+			"username": "Whaling",
+			"content":  text,
+		})
+	default:
+		body, err = json.Marshal(struct {
+			AccountID      string
+			Text           string
+			ResourceEarned []events.ResourceEarned
+			ShipAdditions  []events.ShipAddition
+		}{r.AccountID, text, batch.ResourceEarned, batch.ShipAdditions})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return Deliver(ctx, d.Client, Request{URL: r.URL, Body: body}, d.Retry)
+}
@@ -0,0 +1,143 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// Kind is the format notifications are sent in
+type Kind string
+
+const (
+	// Discord sends a Discord webhook message
+	Discord Kind = "discord"
+	// Generic sends the rendered text together with the events as JSON
+	Generic Kind = "generic"
+)
+
+var (
+	ErrInvalidURL  = errors.New("webhook url must be an absolute https url")
+	ErrInvalidKind = errors.New("kind must be discord or generic")
+	ErrNotDiscord  = errors.New("discord webhooks must point to discord.com/api/webhooks")
+)
+
+// Registration is the webhook a subscriber wants to be notified on
+type Registration struct {
+	AccountID string
+	URL       string
+	Kind      Kind
+	// DisplayName is the name used in the messages, the account ID if empty
+	DisplayName string
+	// Resources limits the notifications to the given resources, all resources if empty
+	Resources []wows.Resource
+	// ShipAdditions enables notifications for new ships in port
+	ShipAdditions bool
+	CreatedAt     int64
+}
+
+// Validate checks the URL and kind of the registration
+func (r *Registration) Validate() error {
+	u, err := url.Parse(r.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidURL
+	}
+
+	switch r.Kind {
+	case Discord:
+		host := strings.TrimPrefix(u.Hostname(), "ptb.")
+		if (host != "discord.com" && host != "discordapp.com") || !strings.HasPrefix(u.Path, "/api/webhooks/") {
+			return ErrNotDiscord
+		}
+	case Generic:
+	default:
+		return ErrInvalidKind
+	}
+	return nil
+}
+
+// Wants returns whether the registration asked for notifications about the resource
+func (r *Registration) Wants(resource wows.Resource) bool {
+	if len(r.Resources) == 0 {
+		return true
+	}
+	for _, wanted := range r.Resources {
+		if wanted == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRegistration returns the registration of an account or nil if there is none
+func GetRegistration(accountID string) (*Registration, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	result, err := svc.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String("whaling-notifications"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Item) == 0 {
+		return nil, nil
+	}
+
+	item := Registration{}
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &item); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return &item, nil
+}
+
+// PutRegistration creates or replaces the registration of an account
+func PutRegistration(r *Registration) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	if r.CreatedAt == 0 {
+		r.CreatedAt = time.Now().UnixNano()
+	}
+
+	av, err := dynamodbattribute.MarshalMap(r)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String("whaling-notifications"),
+		Item:      av,
+	})
+	return err
+}
+
+// DeleteRegistration removes the registration of an account
+func DeleteRegistration(accountID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String("whaling-notifications"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+	})
+	return err
+}
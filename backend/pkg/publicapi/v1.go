@@ -0,0 +1,257 @@
+// Package publicapi contains the documents of the versioned read API, see openapi/v1.yaml.
+//
+// The documents are built from the stored SubscriberPublicData, whose format stays as it is for the frontend.
+// Field names of a version never change, new fields may be added.
+package publicapi
+
+import (
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"sort"
+	"time"
+)
+
+// Version is the version of the documents in this file
+const Version = "v1"
+
+// Resource is the progress of a single resource
+type Resource struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Amount uint   `json:"amount"`
+	Earned uint   `json:"earned"`
+	// ByBattleType splits earned by battle type, it is only set for totals
+	ByBattleType map[string]uint `json:"byBattleType,omitempty"`
+}
+
+// ShipCounts summarizes the ships of a subscriber
+type ShipCounts struct {
+	Total  int `json:"total"`
+	Earned int `json:"earned"`
+}
+
+// Progress is the document returned by /v1/subscribers/{accountId}/progress
+type Progress struct {
+	Version     string     `json:"version"`
+	AccountID   string     `json:"accountId"`
+	Realm       string     `json:"realm"`
+	LastUpdated time.Time  `json:"lastUpdated"`
+	Revision    int64      `json:"revision"`
+	Resources   []Resource `json:"resources"`
+	Ships       ShipCounts `json:"ships"`
+	// Limited is set when the last refresh could not read the private data of the account
+	Limited *Limited `json:"limited,omitempty"`
+}
+
+// Limited tells why the progress may be out of date
+type Limited struct {
+	// Reason is hidden_profile or missing_private
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+}
+
+// Ship is a single ship in the document returned by /v1/subscribers/{accountId}/ships
+type Ship struct {
+	ShipID   int64  `json:"shipId"`
+	Name     string `json:"name"`
+	Tier     int    `json:"tier,omitempty"`
+	Nation   string `json:"nation,omitempty"`
+	Type     string `json:"type,omitempty"`
+	InGarage bool   `json:"inGarage"`
+	// LastBattle is not set for ships that were never played
+	LastBattle *time.Time `json:"lastBattle,omitempty"`
+	Battles    int        `json:"battles"`
+	Resource   Resource   `json:"resource"`
+	Earned     bool       `json:"earned"`
+	// Credit is only set for ships that earned their resource
+	Credit *Credit `json:"credit,omitempty"`
+}
+
+// Credit tells how a ship earned its resource
+type Credit struct {
+	BattleType string `json:"battleType"`
+	// CreditedAt and BattleTime are not set for credits made before they were recorded
+	CreditedAt *time.Time `json:"creditedAt,omitempty"`
+	BattleTime *time.Time `json:"battleTime,omitempty"`
+	// ManualStatus is set for ships marked as played, it is pending, confirmed or flagged
+	ManualStatus string `json:"manualStatus,omitempty"`
+}
+
+// Ships is the document returned by /v1/subscribers/{accountId}/ships
+type Ships struct {
+	Version   string `json:"version"`
+	AccountID string `json:"accountId"`
+	Ships     []Ship `json:"ships"`
+}
+
+func newResource(r *storage.EarnableResource) Resource {
+	return Resource{
+		ID:           r.Type.ID(),
+		Name:         r.Type.Name(),
+		Amount:       r.Amount,
+		Earned:       r.Earned,
+		ByBattleType: r.ByBattleType,
+	}
+}
+
+func newCredit(stored *storage.StoredShip) *Credit {
+	if stored.Resource.Earned == 0 {
+		return nil
+	}
+
+	credit := &Credit{BattleType: stored.BattleType()}
+	if stored.ManualCredit != nil {
+		credit.ManualStatus = stored.ManualCredit.Status
+
+		markedAt := time.Unix(0, stored.ManualCredit.MarkedAt).UTC()
+		credit.CreditedAt = &markedAt
+	}
+	if stored.Credit == nil {
+		return credit
+	}
+
+	creditedAt := time.Unix(0, stored.Credit.CreditedAt).UTC()
+	credit.CreditedAt = &creditedAt
+	if stored.Credit.LastBattleTime > 0 {
+		battleTime := time.Unix(stored.Credit.LastBattleTime, 0).UTC()
+		credit.BattleTime = &battleTime
+	}
+	return credit
+}
+
+// NewProgress creates the progress document. Resources that can not be earned in the event are left out.
+//
+// The totals of data are recomputed, as data saved before they contained amounts only has the earned resources.
+func NewProgress(realm string, data *storage.SubscriberPublicData) Progress {
+	data.UpdateEarnedResources()
+
+	progress := Progress{
+		Version:     Version,
+		AccountID:   data.AccountID,
+		Realm:       realm,
+		LastUpdated: time.Unix(0, data.LastUpdated).UTC(),
+		Revision:    data.Revision,
+		Resources:   []Resource{},
+	}
+	if data.Limited != nil {
+		progress.Limited = &Limited{
+			Reason: data.Limited.Reason,
+			Since:  time.Unix(0, data.Limited.Since).UTC(),
+		}
+	}
+
+	for _, ship := range data.Ships {
+		progress.Ships.Total++
+		if ship.Resource.Earned > 0 {
+			progress.Ships.Earned++
+		}
+	}
+
+	for _, r := range wows.Resources {
+		total := data.Resources.Get(r)
+		if total.Amount == 0 {
+			continue
+		}
+		progress.Resources = append(progress.Resources, newResource(&total))
+	}
+	return progress
+}
+
+// NewShips creates the ships document, sorted by tier and name. Ships missing from the catalogue only have their ID.
+func NewShips(data *storage.SubscriberPublicData) Ships {
+	ships := Ships{
+		Version:   Version,
+		AccountID: data.AccountID,
+		Ships:     []Ship{},
+	}
+
+	for _, stored := range data.Ships {
+		ship := Ship{
+			ShipID:   stored.ShipID,
+			Resource: newResource(&stored.Resource),
+			Earned:   stored.Resource.Earned > 0,
+			Credit:   newCredit(stored),
+		}
+
+		if stored.ShipStatistics != nil {
+			ship.Battles = stored.Battles
+			if stored.Private != nil {
+				ship.InGarage = stored.Private.InGarage
+			}
+			if stored.LastBattleTime > 0 {
+				lastBattle := time.Unix(int64(stored.LastBattleTime), 0).UTC()
+				ship.LastBattle = &lastBattle
+			}
+		}
+
+		if warship, ok := catalogue.Default.Get(stored.ShipID); ok {
+			ship.Name = warship.Name
+			ship.Tier = warship.Tier
+			ship.Nation = warship.Nation
+			ship.Type = string(warship.Type)
+		}
+
+		ships.Ships = append(ships.Ships, ship)
+	}
+
+	sort.Slice(ships.Ships, func(i, j int) bool {
+		a, b := ships.Ships[i], ships.Ships[j]
+		if a.Tier != b.Tier {
+			return a.Tier < b.Tier
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ShipID < b.ShipID
+	})
+	return ships
+}
+
+// TimelinePoint is the progress after a refresh
+type TimelinePoint struct {
+	Timestamp time.Time  `json:"timestamp"`
+	Resources []Resource `json:"resources"`
+	Ships     ShipCounts `json:"ships"`
+	// ShipsAdded and ShipsEarned are the ships added or earned since the previous point
+	ShipsAdded  []int64 `json:"shipsAdded"`
+	ShipsEarned []int64 `json:"shipsEarned"`
+}
+
+// Timeline is the document returned by /v1/subscribers/{accountId}/timeline
+type Timeline struct {
+	Version   string          `json:"version"`
+	AccountID string          `json:"accountId"`
+	Points    []TimelinePoint `json:"points"`
+}
+
+// NewTimeline creates the timeline document from the history, oldest point first
+func NewTimeline(history *storage.History) Timeline {
+	timeline := Timeline{
+		Version:   Version,
+		AccountID: history.AccountID,
+		Points:    []TimelinePoint{},
+	}
+
+	for _, snapshot := range history.Snapshots {
+		point := TimelinePoint{
+			Timestamp:   time.Unix(0, snapshot.Timestamp).UTC(),
+			Resources:   []Resource{},
+			Ships:       ShipCounts{Total: snapshot.Ships, Earned: snapshot.EarnedShips},
+			ShipsAdded:  []int64{},
+			ShipsEarned: []int64{},
+		}
+		point.ShipsAdded = append(point.ShipsAdded, snapshot.Added...)
+		point.ShipsEarned = append(point.ShipsEarned, snapshot.Earned...)
+
+		for _, r := range wows.Resources {
+			total := snapshot.Resources.Get(r)
+			if total.Amount == 0 {
+				continue
+			}
+			point.Resources = append(point.Resources, newResource(&total))
+		}
+		timeline.Points = append(timeline.Points, point)
+	}
+	return timeline
+}
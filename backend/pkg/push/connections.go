@@ -0,0 +1,89 @@
+package push
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ConnectionTTL is how long a connection is kept when the disconnect was missed. API Gateway closes
+// WebSocket connections after two hours anyway.
+var ConnectionTTL = 3 * time.Hour
+
+// Connection is an open WebSocket connection of a subscriber
+type Connection struct {
+	ConnectionID string
+	AccountID    string
+	ConnectedAt  int64
+	// ExpiresAt is the Unix timestamp at which DynamoDB deletes the connection
+	ExpiresAt int64
+}
+
+// AddConnection stores a new connection
+func AddConnection(connectionID, accountID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	now := time.Now()
+	av, err := dynamodbattribute.MarshalMap(Connection{
+		ConnectionID: connectionID,
+		AccountID:    accountID,
+		ConnectedAt:  now.UnixNano(),
+		ExpiresAt:    now.Add(ConnectionTTL).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String("whaling-connections"),
+		Item:      av,
+	})
+	return err
+}
+
+// RemoveConnection deletes a connection
+func RemoveConnection(connectionID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String("whaling-connections"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"ConnectionID": {
+				S: aws.String(connectionID),
+			},
+		},
+	})
+	return err
+}
+
+// GetConnections returns all connections of an account
+func GetConnections(accountID string) ([]*Connection, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	out, err := svc.Query(&dynamodb.QueryInput{
+		TableName: aws.String("whaling-connections"),
+		IndexName: aws.String("AccountID-index"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":a": {
+				S: aws.String(accountID),
+			},
+		},
+		KeyConditionExpression: aws.String("AccountID = :a"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var connections []*Connection
+	if err := dynamodbattribute.UnmarshalListOfMaps(out.Items, &connections); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return connections, nil
+}
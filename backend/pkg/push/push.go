@@ -0,0 +1,103 @@
+// Package push sends the progress of refreshes to browsers connected through the WebSocket API.
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/events"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewaymanagementapi"
+)
+
+// Message types
+const (
+	RefreshStarted   = "RefreshStarted"
+	RefreshCompleted = "RefreshCompleted"
+	RefreshFailed    = "RefreshFailed"
+)
+
+// Message is sent to all connections of an account
+type Message struct {
+	Type      string
+	AccountID string
+	Timestamp int64
+	// Reason is set when the refresh failed
+	Reason string `json:",omitempty"`
+	// Earned are the ships that were credited by the refresh
+	Earned []events.ResourceEarned `json:",omitempty"`
+	// Limited is why the refreshed data is limited, see storage.Limited
+	Limited string `json:",omitempty"`
+}
+
+// NewMessage creates a message of the given type
+func NewMessage(messageType, accountID string) Message {
+	return Message{
+		Type:      messageType,
+		AccountID: accountID,
+		Timestamp: time.Now().UnixNano(),
+	}
+}
+
+// Client posts messages to WebSocket connections
+type Client struct {
+	api *apigatewaymanagementapi.ApiGatewayManagementApi
+}
+
+// NewClient creates a client for the WebSocket API in WEBSOCKET_ENDPOINT. It returns nil if
+// the endpoint is not set, all methods of a nil client do nothing.
+func NewClient() *Client {
+	endpoint := os.Getenv("WEBSOCKET_ENDPOINT")
+	if endpoint == "" {
+		return nil
+	}
+
+	sess := session.Must(session.NewSession())
+	return &Client{
+		api: apigatewaymanagementapi.New(sess, aws.NewConfig().WithEndpoint(endpoint)),
+	}
+}
+
+// Connections returns the open connections of an account
+func (c *Client) Connections(accountID string) ([]*Connection, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return GetConnections(accountID)
+}
+
+// Send posts the message to the connections. Connections that were closed without a disconnect are removed.
+func (c *Client) Send(ctx context.Context, connections []*Connection, message Message) {
+	if c == nil || len(connections) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("ERROR: push: could not marshal message type=%s error=%v", message.Type, err)
+		return
+	}
+
+	for _, conn := range connections {
+		_, err := c.api.PostToConnectionWithContext(ctx, &apigatewaymanagementapi.PostToConnectionInput{
+			ConnectionId: aws.String(conn.ConnectionID),
+			Data:         data,
+		})
+		if err == nil {
+			continue
+		}
+
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == apigatewaymanagementapi.ErrCodeGoneException {
+			if err := RemoveConnection(conn.ConnectionID); err != nil {
+				log.Printf("WARN: push: could not remove gone connection connectionId=%s error=%v", conn.ConnectionID, err)
+			}
+			continue
+		}
+		log.Printf("WARN: push: could not send message accountId=%s connectionId=%s type=%s error=%v", message.AccountID, conn.ConnectionID, message.Type, err)
+	}
+}
@@ -0,0 +1,185 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// File is a queue stored in a directory, so that refreshes can be queued and processed locally by
+// separate processes. Every lane has its own directory with one file per key, which means a new message
+// for an account replaces the one that is still waiting.
+//
+// Received messages are moved to processing/<lane>/<key>.<deadline>, and moved back into their lane once the
+// deadline passed without them being acknowledged.
+type File struct {
+	Dir string
+	// VisibilityTimeout is how long a received message is hidden before it is handed out again
+	VisibilityTimeout time.Duration
+}
+
+// NewFile creates the queue in dir
+func NewFile(dir string) (*File, error) {
+	for _, lane := range Lanes {
+		if err := os.MkdirAll(filepath.Join(dir, string(lane)), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Join(dir, "processing", string(lane)), 0755); err != nil {
+			return nil, err
+		}
+	}
+	return &File{Dir: dir, VisibilityTimeout: DefaultVisibilityTimeout}, nil
+}
+
+// Publish writes the messages into the directories of their lanes
+func (f *File) Publish(ctx context.Context, messages []Message) error {
+	for _, m := range dedup(messages) {
+		if !consumed(m.Lane) {
+			return fmt.Errorf("queue: lane %s is not consumed from the file backend", m.Lane)
+		}
+
+		key := m.Key
+		if key == "" {
+			key = fmt.Sprintf("%d", time.Now().UnixNano())
+		}
+
+		// Write to a temporary file first, so that a consumer never sees half a message
+		tmp, err := ioutil.TempFile(f.Dir, "publish-")
+		if err != nil {
+			return err
+		}
+		if _, err := tmp.Write(m.Body); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+		tmp.Close()
+
+		if err := os.Rename(tmp.Name(), f.path(m.Lane, key)); err != nil {
+			os.Remove(tmp.Name())
+			return err
+		}
+	}
+	return nil
+}
+
+// Receive moves the oldest messages to the processing directory, manual messages first
+func (f *File) Receive(ctx context.Context, max int) ([]Message, error) {
+	for {
+		messages, err := f.take(max)
+		if err != nil || len(messages) > 0 {
+			return messages, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (f *File) take(max int) ([]Message, error) {
+	now := time.Now()
+	if err := f.requeueExpired(now); err != nil {
+		return nil, err
+	}
+
+	var messages []Message
+	for _, lane := range Lanes {
+		files, err := ioutil.ReadDir(filepath.Join(f.Dir, string(lane)))
+		if err != nil {
+			return messages, err
+		}
+		sort.Slice(files, func(i, j int) bool {
+			return files[i].ModTime().Before(files[j].ModTime())
+		})
+
+		for _, file := range files {
+			if len(messages) >= max {
+				return messages, nil
+			}
+
+			key := strings.TrimSuffix(file.Name(), ".json")
+			processing := filepath.Join(f.Dir, "processing", string(lane), fmt.Sprintf("%s.%d", key, now.Add(f.VisibilityTimeout).UnixNano()))
+			if err := os.Rename(f.path(lane, key), processing); err != nil {
+				// Another consumer was faster
+				continue
+			}
+
+			body, err := ioutil.ReadFile(processing)
+			if err != nil {
+				return messages, err
+			}
+			messages = append(messages, Message{
+				Lane:    lane,
+				Key:     key,
+				Body:    json.RawMessage(body),
+				receipt: processing,
+			})
+		}
+	}
+	return messages, nil
+}
+
+// requeueExpired moves the messages whose deadline passed back into their lane. If a new message for the key was
+// published in the meantime, it replaces the expired one.
+func (f *File) requeueExpired(now time.Time) error {
+	for _, lane := range Lanes {
+		files, err := ioutil.ReadDir(filepath.Join(f.Dir, "processing", string(lane)))
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			dot := strings.LastIndex(file.Name(), ".")
+			if dot < 0 {
+				continue
+			}
+			deadline, err := strconv.ParseInt(file.Name()[dot+1:], 10, 64)
+			if err != nil || now.UnixNano() < deadline {
+				continue
+			}
+
+			// Linking fails if a newer message exists, which then stays, or if another consumer put it back first
+			processing := filepath.Join(f.Dir, "processing", string(lane), file.Name())
+			if err := os.Link(processing, f.path(lane, file.Name()[:dot])); err != nil && !os.IsExist(err) {
+				continue
+			}
+			os.Remove(processing)
+		}
+	}
+	return nil
+}
+
+// Ack removes the messages from the processing directory. Messages that were put back into their lane in the
+// meantime are handed out again.
+func (f *File) Ack(ctx context.Context, messages []Message) error {
+	for _, m := range messages {
+		if err := os.Remove(m.receipt); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// consumed returns whether the lane is handed out by Receive
+func consumed(lane Lane) bool {
+	for _, l := range Lanes {
+		if l == lane {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *File) path(lane Lane, key string) string {
+	return filepath.Join(f.Dir, string(lane), filepath.Base(key)+".json")
+}
@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// lambdaEvent covers the parts of SNS and SQS lambda events that are needed to get the messages
+type lambdaEvent struct {
+	Records []struct {
+		EventSource string
+		Body        string
+		SNS         struct {
+			Message string
+		} `json:"Sns"`
+	}
+}
+
+// ParseLambdaEvent returns the message bodies of a lambda invocation by either SNS or SQS.
+// SNS notifications carry a JSON array of bodies, while every SQS record is a single body.
+func ParseLambdaEvent(payload []byte) ([]json.RawMessage, error) {
+	var event lambdaEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	var bodies []json.RawMessage
+	for _, record := range event.Records {
+		switch record.EventSource {
+		case "aws:sns":
+			var batch []json.RawMessage
+			if err := json.Unmarshal([]byte(record.SNS.Message), &batch); err != nil {
+				return nil, err
+			}
+			bodies = append(bodies, batch...)
+		case "aws:sqs":
+			bodies = append(bodies, json.RawMessage(record.Body))
+		default:
+			return nil, fmt.Errorf("queue: unsupported event source %q", record.EventSource)
+		}
+	}
+	return bodies, nil
+}
@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Memory is a queue kept in memory, for running the refresh locally and in a single process
+type Memory struct {
+	// VisibilityTimeout is how long a received message is hidden before it is handed out again
+	VisibilityTimeout time.Duration
+
+	lanes map[Lane]chan Message
+
+	mu sync.Mutex
+	// pending holds the keys that were published but not acknowledged yet
+	pending map[Lane]map[string]bool
+	// inFlight holds the received messages that were not acknowledged yet by their receipt
+	inFlight map[string]inFlightMessage
+	receipts int64
+}
+
+type inFlightMessage struct {
+	message Message
+	until   time.Time
+}
+
+// NewMemory creates a queue that holds up to size messages per lane
+func NewMemory(size int) *Memory {
+	m := &Memory{
+		VisibilityTimeout: DefaultVisibilityTimeout,
+		lanes:             map[Lane]chan Message{},
+		pending:           map[Lane]map[string]bool{},
+		inFlight:          map[string]inFlightMessage{},
+	}
+	for _, lane := range allLanes {
+		m.pending[lane] = map[string]bool{}
+	}
+	for _, lane := range Lanes {
+		m.lanes[lane] = make(chan Message, size)
+	}
+	return m
+}
+
+// Publish adds the messages, skipping those with a key that is still pending. It blocks while a lane is full.
+func (m *Memory) Publish(ctx context.Context, messages []Message) error {
+	for _, message := range messages {
+		ch, ok := m.lanes[message.Lane]
+		if !ok {
+			return fmt.Errorf("queue: lane %s is not consumed from the memory backend", message.Lane)
+		}
+
+		m.mu.Lock()
+		if message.Key != "" && m.pending[message.Lane][message.Key] {
+			m.mu.Unlock()
+			continue
+		}
+		m.pending[message.Lane][message.Key] = true
+		m.mu.Unlock()
+
+		select {
+		case ch <- message:
+		case <-ctx.Done():
+			m.release(message)
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Receive waits for the first message and then takes whatever else is available, manual messages first
+func (m *Memory) Receive(ctx context.Context, max int) ([]Message, error) {
+	var messages []Message
+	for len(messages) == 0 {
+		messages = m.take(max)
+		if len(messages) > 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return messages, nil
+}
+
+func (m *Memory) take(max int) []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.requeueExpired(now)
+
+	var messages []Message
+	for _, lane := range Lanes {
+	drain:
+		for len(messages) < max {
+			select {
+			case message := <-m.lanes[lane]:
+				m.receipts++
+				message.receipt = strconv.FormatInt(m.receipts, 10)
+				m.inFlight[message.receipt] = inFlightMessage{message: message, until: now.Add(m.VisibilityTimeout)}
+				messages = append(messages, message)
+			default:
+				break drain
+			}
+		}
+	}
+	return messages
+}
+
+// requeueExpired puts messages that were not acknowledged within the visibility timeout back into their lane.
+// Messages stay in flight while their lane is full and are put back by a later call.
+func (m *Memory) requeueExpired(now time.Time) {
+	for receipt, f := range m.inFlight {
+		if now.Before(f.until) {
+			continue
+		}
+
+		message := f.message
+		message.receipt = ""
+		select {
+		case m.lanes[message.Lane] <- message:
+			delete(m.inFlight, receipt)
+		default:
+		}
+	}
+}
+
+// Ack allows the keys of the messages to be published again. Messages that were handed out again since they were
+// received are acknowledged by their new receipt.
+func (m *Memory) Ack(ctx context.Context, messages []Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, message := range messages {
+		if _, ok := m.inFlight[message.receipt]; !ok {
+			continue
+		}
+		delete(m.inFlight, message.receipt)
+		delete(m.pending[message.Lane], message.Key)
+	}
+	return nil
+}
+
+func (m *Memory) release(message Message) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pending[message.Lane], message.Key)
+}
@@ -0,0 +1,137 @@
+// Package queue sends refresh requests to the refresh workers.
+//
+// Messages are put into one of two lanes: manual refreshes, which a player is waiting for, are always handed out
+// before scheduled ones. Every message has a key (the account ID), and a lane holds at most one pending message per key.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Lane is the priority of a message
+type Lane string
+
+const (
+	// Manual is used for refreshes requested by a player, they are processed first
+	Manual Lane = "ManualRefresh"
+	// Scheduled is used for refreshes sent by the scheduler
+	Scheduled Lane = "Refresh"
+	// Notification is used for the notifications of a refresh. It is only published to SNS, where the
+	// sendNotifications function delivers them, and is not consumed by the refresh workers.
+	Notification Lane = "Notification"
+)
+
+// Lanes is the order in which the lanes are consumed
+var Lanes = []Lane{Manual, Scheduled}
+
+// allLanes are all lanes in the order they are published
+var allLanes = []Lane{Manual, Scheduled, Notification}
+
+// DefaultVisibilityTimeout is how long the memory and file backends hide a received message before handing it out
+// again when it was not acknowledged. It has to be longer than a batch of refreshes takes.
+const DefaultVisibilityTimeout = 5 * time.Minute
+
+// Message is a single refresh request
+type Message struct {
+	Lane Lane
+	// Key identifies the account, messages with the same key in a lane are deduplicated
+	Key  string
+	Body json.RawMessage
+
+	// receipt is used by the consumer to acknowledge the message
+	receipt string
+}
+
+// Publisher sends messages
+type Publisher interface {
+	Publish(ctx context.Context, messages []Message) error
+}
+
+// Consumer receives messages. Received messages have to be acknowledged once they were processed,
+// otherwise they are handed out again.
+type Consumer interface {
+	// Receive returns up to max messages, manual messages first. It waits for a message to become available,
+	// but may return no messages once the backend gives up waiting.
+	Receive(ctx context.Context, max int) ([]Message, error)
+	Ack(ctx context.Context, messages []Message) error
+}
+
+// Queue is a queue that can be published to and consumed from
+type Queue interface {
+	Publisher
+	Consumer
+}
+
+// Backend configuration through the environment:
+//
+// QUEUE_BACKEND selects the implementation: sns (default), sqs, memory or file.
+// sns publishes to TOPIC_ARN, sqs uses MANUAL_QUEUE_URL and SCHEDULED_QUEUE_URL and file stores the messages in QUEUE_DIR.
+var (
+	memoryOnce  sync.Once
+	memoryQueue *Memory
+)
+
+// NewPublisher creates the publisher configured in the environment
+func NewPublisher() (Publisher, error) {
+	switch backend := os.Getenv("QUEUE_BACKEND"); backend {
+	case "", "sns":
+		topic := os.Getenv("TOPIC_ARN")
+		if topic == "" {
+			return nil, fmt.Errorf("queue: TOPIC_ARN is not set")
+		}
+		return NewSNS(topic), nil
+	default:
+		return NewConsumer()
+	}
+}
+
+// NewConsumer creates the queue configured in the environment. SNS cannot be consumed, it pushes the messages
+// to the refresh lambda functions.
+func NewConsumer() (Queue, error) {
+	switch backend := os.Getenv("QUEUE_BACKEND"); backend {
+	case "sqs":
+		manual, scheduled := os.Getenv("MANUAL_QUEUE_URL"), os.Getenv("SCHEDULED_QUEUE_URL")
+		if manual == "" || scheduled == "" {
+			return nil, fmt.Errorf("queue: MANUAL_QUEUE_URL and SCHEDULED_QUEUE_URL have to be set")
+		}
+		return NewSQS(manual, scheduled), nil
+	case "memory":
+		// All users in the same process share one queue
+		memoryOnce.Do(func() {
+			memoryQueue = NewMemory(1000)
+		})
+		return memoryQueue, nil
+	case "file":
+		dir := os.Getenv("QUEUE_DIR")
+		if dir == "" {
+			dir = "queue"
+		}
+		return NewFile(dir)
+	case "", "sns":
+		return nil, fmt.Errorf("queue: the sns backend cannot be consumed")
+	default:
+		return nil, fmt.Errorf("queue: unknown backend %s", backend)
+	}
+}
+
+// dedup removes messages with a key that was already seen in the same lane, keeping the first one
+func dedup(messages []Message) []Message {
+	seen := map[Lane]map[string]bool{}
+	var unique []Message
+	for _, m := range messages {
+		if seen[m.Lane] == nil {
+			seen[m.Lane] = map[string]bool{}
+		}
+		if m.Key != "" && seen[m.Lane][m.Key] {
+			continue
+		}
+		seen[m.Lane][m.Key] = true
+		unique = append(unique, m)
+	}
+	return unique
+}
@@ -0,0 +1,187 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// backend creates an empty queue with the given visibility timeout, and a function that removes it again
+type backend func(t *testing.T, visibilityTimeout time.Duration) (Queue, func())
+
+var backends = map[string]backend{
+	"memory": func(t *testing.T, visibilityTimeout time.Duration) (Queue, func()) {
+		m := NewMemory(10)
+		m.VisibilityTimeout = visibilityTimeout
+		return m, func() {}
+	},
+	"file": func(t *testing.T, visibilityTimeout time.Duration) (Queue, func()) {
+		dir, err := ioutil.TempDir("", "queue")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := NewFile(dir)
+		if err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+		f.VisibilityTimeout = visibilityTimeout
+		return f, func() { os.RemoveAll(dir) }
+	},
+}
+
+func message(lane Lane, key string) Message {
+	return Message{Lane: lane, Key: key, Body: json.RawMessage(`{"AccountID":"` + key + `"}`)}
+}
+
+func publish(t *testing.T, q Queue, messages ...Message) {
+	if err := q.Publish(context.Background(), messages); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// receive returns the lanes and keys of the received messages, an empty queue returns nothing
+func receive(t *testing.T, q Queue, max int) ([]Message, []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	messages, err := q.Receive(ctx, max)
+	if err != nil && err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	received := []string{}
+	for _, m := range messages {
+		received = append(received, string(m.Lane)+"/"+m.Key)
+	}
+	return messages, received
+}
+
+func TestLanePriority(t *testing.T) {
+	for name, newQueue := range backends {
+		t.Run(name, func(t *testing.T) {
+			q, cleanup := newQueue(t, time.Minute)
+			defer cleanup()
+
+			publish(t, q, message(Scheduled, "1"))
+			publish(t, q, message(Scheduled, "2"))
+			publish(t, q, message(Manual, "3"))
+
+			_, received := receive(t, q, 2)
+			if expected := []string{"ManualRefresh/3", "Refresh/1"}; !reflect.DeepEqual(received, expected) {
+				t.Errorf("received %v, want %v", received, expected)
+			}
+			_, received = receive(t, q, 2)
+			if expected := []string{"Refresh/2"}; !reflect.DeepEqual(received, expected) {
+				t.Errorf("received %v, want %v", received, expected)
+			}
+		})
+	}
+}
+
+func TestDedupAndAck(t *testing.T) {
+	for name, newQueue := range backends {
+		t.Run(name, func(t *testing.T) {
+			q, cleanup := newQueue(t, time.Minute)
+			defer cleanup()
+
+			publish(t, q, message(Scheduled, "1"), message(Scheduled, "1"), message(Manual, "1"))
+			publish(t, q, message(Scheduled, "1"))
+
+			messages, received := receive(t, q, 10)
+			if expected := []string{"ManualRefresh/1", "Refresh/1"}; !reflect.DeepEqual(received, expected) {
+				t.Fatalf("received %v, want %v", received, expected)
+			}
+			if err := q.Ack(context.Background(), messages); err != nil {
+				t.Fatal(err)
+			}
+
+			// Acknowledged messages are gone and their keys can be published again
+			if _, received := receive(t, q, 10); len(received) != 0 {
+				t.Errorf("received %v after ack, want nothing", received)
+			}
+			publish(t, q, message(Scheduled, "1"))
+			if _, received := receive(t, q, 10); !reflect.DeepEqual(received, []string{"Refresh/1"}) {
+				t.Errorf("received %v after publishing again, want Refresh/1", received)
+			}
+		})
+	}
+}
+
+func TestRedelivery(t *testing.T) {
+	for name, newQueue := range backends {
+		t.Run(name, func(t *testing.T) {
+			q, cleanup := newQueue(t, 20*time.Millisecond)
+			defer cleanup()
+
+			publish(t, q, message(Scheduled, "1"))
+			first, received := receive(t, q, 10)
+			if !reflect.DeepEqual(received, []string{"Refresh/1"}) {
+				t.Fatalf("received %v, want Refresh/1", received)
+			}
+
+			// The message is hidden until the visibility timeout passed
+			if _, received := receive(t, q, 10); len(received) != 0 {
+				t.Errorf("received %v within the visibility timeout, want nothing", received)
+			}
+			time.Sleep(30 * time.Millisecond)
+
+			second, received := receive(t, q, 10)
+			if !reflect.DeepEqual(received, []string{"Refresh/1"}) {
+				t.Fatalf("received %v after the visibility timeout, want Refresh/1", received)
+			}
+			if string(second[0].Body) != string(first[0].Body) {
+				t.Errorf("body = %s, want %s", second[0].Body, first[0].Body)
+			}
+
+			// The late ack of the first delivery does not remove the second one
+			if err := q.Ack(context.Background(), first); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(30 * time.Millisecond)
+			third, received := receive(t, q, 10)
+			if !reflect.DeepEqual(received, []string{"Refresh/1"}) {
+				t.Fatalf("received %v after a late ack, want Refresh/1", received)
+			}
+
+			if err := q.Ack(context.Background(), third); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(30 * time.Millisecond)
+			if _, received := receive(t, q, 10); len(received) != 0 {
+				t.Errorf("received %v after ack, want nothing", received)
+			}
+		})
+	}
+}
+
+func TestMemoryDedupWhileInFlight(t *testing.T) {
+	q := NewMemory(10)
+	q.VisibilityTimeout = 20 * time.Millisecond
+
+	publish(t, q, message(Scheduled, "1"))
+	receive(t, q, 10)
+
+	// The key is pending until the message is acknowledged, also while it waits to be handed out again
+	publish(t, q, message(Scheduled, "1"))
+	time.Sleep(30 * time.Millisecond)
+	if _, received := receive(t, q, 10); !reflect.DeepEqual(received, []string{"Refresh/1"}) {
+		t.Errorf("received %v, want a single Refresh/1", received)
+	}
+}
+
+func TestNotificationLaneIsNotConsumed(t *testing.T) {
+	for name, newQueue := range backends {
+		t.Run(name, func(t *testing.T) {
+			q, cleanup := newQueue(t, time.Minute)
+			defer cleanup()
+
+			if err := q.Publish(context.Background(), []Message{message(Notification, "1")}); err == nil {
+				t.Error("publishing a notification succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+// SNSBatchSize is the number of messages sent in a single SNS notification
+const SNSBatchSize = 100
+
+// SNS publishes messages to a topic. The messages of a lane are sent as JSON array with the lane in the
+// `Type` attribute, which the subscriptions of the refresh functions filter on.
+type SNS struct {
+	TopicArn string
+
+	client *sns.SNS
+}
+
+// NewSNS creates a publisher for the topic
+func NewSNS(topicArn string) *SNS {
+	return &SNS{
+		TopicArn: topicArn,
+		client:   sns.New(session.Must(session.NewSession())),
+	}
+}
+
+// Publish sends the messages. SNS cannot deduplicate across calls, duplicates are only removed within the same call.
+func (s *SNS) Publish(ctx context.Context, messages []Message) error {
+	byLane := map[Lane][]json.RawMessage{}
+	for _, m := range dedup(messages) {
+		byLane[m.Lane] = append(byLane[m.Lane], m.Body)
+	}
+
+	for _, lane := range allLanes {
+		bodies := byLane[lane]
+		for start := 0; start < len(bodies); start += SNSBatchSize {
+			end := start + SNSBatchSize
+			if end > len(bodies) {
+				end = len(bodies)
+			}
+
+			data, err := json.Marshal(bodies[start:end])
+			if err != nil {
+				return err
+			}
+
+			if _, err := s.client.PublishWithContext(ctx, &sns.PublishInput{
+				Message:  aws.String(string(data)),
+				TopicArn: aws.String(s.TopicArn),
+				MessageAttributes: map[string]*sns.MessageAttributeValue{
+					"Type": &sns.MessageAttributeValue{
+						DataType:    aws.String("String"),
+						StringValue: aws.String(string(lane)),
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// SQS uses one queue per lane. With FIFO queues (URL ending in .fifo) SQS deduplicates messages of the same
+// account sent within five minutes, standard queues only deduplicate within the same Publish call.
+type SQS struct {
+	URLs map[Lane]string
+
+	client *sqs.SQS
+}
+
+// NewSQS creates a queue using the two queue URLs
+func NewSQS(manualURL, scheduledURL string) *SQS {
+	return &SQS{
+		URLs: map[Lane]string{
+			Manual:    manualURL,
+			Scheduled: scheduledURL,
+		},
+		client: sqs.New(session.Must(session.NewSession())),
+	}
+}
+
+// Publish sends the messages to the queue of their lane
+func (q *SQS) Publish(ctx context.Context, messages []Message) error {
+	byLane := map[Lane][]Message{}
+	for _, m := range dedup(messages) {
+		byLane[m.Lane] = append(byLane[m.Lane], m)
+	}
+
+	for lane, laneMessages := range byLane {
+		url, ok := q.URLs[lane]
+		if !ok {
+			return fmt.Errorf("queue: no queue for lane %s", lane)
+		}
+		fifo := strings.HasSuffix(url, ".fifo")
+
+		// SQS accepts at most 10 messages per batch
+		for start := 0; start < len(laneMessages); start += 10 {
+			end := start + 10
+			if end > len(laneMessages) {
+				end = len(laneMessages)
+			}
+
+			var entries []*sqs.SendMessageBatchRequestEntry
+			for i, m := range laneMessages[start:end] {
+				entry := &sqs.SendMessageBatchRequestEntry{
+					Id:          aws.String(strconv.Itoa(i)),
+					MessageBody: aws.String(string(m.Body)),
+					MessageAttributes: map[string]*sqs.MessageAttributeValue{
+						"Key": {
+							DataType:    aws.String("String"),
+							StringValue: aws.String(m.Key),
+						},
+					},
+				}
+				if fifo {
+					entry.MessageGroupId = aws.String(m.Key)
+					entry.MessageDeduplicationId = aws.String(m.Key)
+				}
+				entries = append(entries, entry)
+			}
+
+			out, err := q.client.SendMessageBatchWithContext(ctx, &sqs.SendMessageBatchInput{
+				QueueUrl: aws.String(url),
+				Entries:  entries,
+			})
+			if err != nil {
+				return err
+			}
+			if len(out.Failed) > 0 {
+				return fmt.Errorf("queue: %d messages could not be sent to %s: %s", len(out.Failed), lane, aws.StringValue(out.Failed[0].Message))
+			}
+		}
+	}
+	return nil
+}
+
+// Receive returns messages from the manual queue first and fills up with scheduled ones. Only the last
+// lane is long polled, so that manual messages are not delayed by an empty manual queue.
+func (q *SQS) Receive(ctx context.Context, max int) ([]Message, error) {
+	var messages []Message
+	for i, lane := range Lanes {
+		if len(messages) >= max {
+			break
+		}
+
+		want := max - len(messages)
+		if want > 10 {
+			want = 10
+		}
+
+		var wait int64
+		if i == len(Lanes)-1 && len(messages) == 0 {
+			wait = 20
+		}
+
+		out, err := q.client.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:              aws.String(q.URLs[lane]),
+			MaxNumberOfMessages:   aws.Int64(int64(want)),
+			WaitTimeSeconds:       aws.Int64(wait),
+			MessageAttributeNames: []*string{aws.String("Key")},
+		})
+		if err != nil {
+			return messages, err
+		}
+
+		for _, m := range out.Messages {
+			message := Message{
+				Lane:    lane,
+				Body:    []byte(aws.StringValue(m.Body)),
+				receipt: aws.StringValue(m.ReceiptHandle),
+			}
+			if key, ok := m.MessageAttributes["Key"]; ok {
+				message.Key = aws.StringValue(key.StringValue)
+			}
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// Ack deletes the messages from their queues
+func (q *SQS) Ack(ctx context.Context, messages []Message) error {
+	for _, m := range messages {
+		if _, err := q.client.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+			QueueUrl:      aws.String(q.URLs[m.Lane]),
+			ReceiptHandle: aws.String(m.receipt),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
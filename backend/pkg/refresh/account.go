@@ -0,0 +1,573 @@
+package refresh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"rukenshia/frenchwhaling/pkg/queue"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/webhooks"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/api"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/getsentry/sentry-go"
+)
+
+// process refreshes the data of a single account and returns the resources that were earned. If the private
+// data of the account could not be read, it also returns why the refreshed data is limited.
+func (e *Engine) process(ctx context.Context, sentryAccountHub *sentry.Hub, ev storage.RefreshEvent) ([]events.ResourceEarned, string, error) {
+	if _, ok := wows.EventStartTime[ev.Realm]; !ok {
+		log.Printf("WARN: Invalid realm for accountId=%s realm=%s", ev.AccountID, ev.Realm)
+		sentryAccountHub.CaptureMessage(fmt.Sprintf("Invalid realm '%s'", ev.Realm))
+		return nil, "", &Failure{Event: ev, Reason: ReasonInvalidRealm, Err: fmt.Errorf("invalid realm %s", ev.Realm)}
+	}
+
+	// Check if the token expires soon
+	if accessTokenExpiresSoon(ev.AccessTokenExpiresAt) {
+		log.Printf("Access token will expire soon. Refreshing accountId=%s expiresAt=%d", ev.AccountID, ev.AccessTokenExpiresAt)
+
+		newToken, err := e.refreshAccessToken(ctx, ev.Realm, ev.AccessToken, ev.AccountID)
+		if err != nil {
+			log.Printf("Could not refresh token: %v", err)
+			e.cloudwatch.PutMetricData(&cloudwatch.PutMetricDataInput{
+				Namespace: aws.String("Whaling"),
+				MetricData: []*cloudwatch.MetricDatum{
+					{
+						MetricName: aws.String("AccessTokenRefresh"),
+						Dimensions: []*cloudwatch.Dimension{
+							{Name: aws.String("Status"), Value: aws.String("Failed")},
+							{Name: aws.String("Realm"), Value: aws.String(ev.Realm)},
+						},
+						Value: aws.Float64(1.0),
+					},
+				},
+			})
+			getHub(sentryAccountHub, E{"error": err.Error(), "expiresAt": ev.AccessTokenExpiresAt}).CaptureMessage("Could not refresh access token")
+		} else {
+			ev.AccessToken = newToken.Data.AccessToken
+			ev.AccessTokenExpiresAt = newToken.Data.ExpiresAt
+
+			e.cloudwatch.PutMetricData(&cloudwatch.PutMetricDataInput{
+				Namespace: aws.String("Whaling"),
+				MetricData: []*cloudwatch.MetricDatum{
+					{
+						MetricName: aws.String("AccessTokenRefresh"),
+						Dimensions: []*cloudwatch.Dimension{
+							{Name: aws.String("Status"), Value: aws.String("Success")},
+							{Name: aws.String("Realm"), Value: aws.String(ev.Realm)},
+						},
+						Value: aws.Float64(1.0),
+					},
+				},
+			})
+
+			if err := storage.SetSubscriberAccessToken(ev.AccountID, ev.AccessToken, ev.AccessTokenExpiresAt); err != nil {
+				log.Printf("Could not update new access token in dynamodb: %v", err)
+				getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not refresh access token")
+			}
+
+			log.Printf("Access token refreshed accountId=%s expiresAt=%d", ev.AccountID, ev.AccessTokenExpiresAt)
+		}
+	}
+
+	// Hidden profiles and missing private data do not fail the refresh, the data is marked as limited instead
+	var limited string
+	newData, err := e.getPlayerShipStatistics(ctx, ev.Realm, ev.AccessToken, ev.AccountID)
+	if err == api.ErrHiddenProfile {
+		log.Printf("WARN: profile is hidden, no statistics accountId=%s", ev.AccountID)
+		limited = storage.LimitedHiddenProfile
+		newData, err = map[int64]*api.ShipStatistics{}, nil
+	}
+	if err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetPlayerShipStatistics failed")
+		log.Printf("ERROR: Processing event: failed for accountId=%s error=%v", ev.AccountID, err)
+
+		if strings.Contains(err.Error(), "INVALID_ACCESS_TOKEN") {
+			if err := storage.SetSubscriberActive(ev.AccountID, false); err != nil {
+				getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not disable subscriber")
+			}
+
+			e.cloudwatch.PutMetricData(&cloudwatch.PutMetricDataInput{
+				Namespace: aws.String("Whaling"),
+				MetricData: []*cloudwatch.MetricDatum{
+					{
+						MetricName: aws.String("PrematureAccessTokenInvalidation"),
+						Dimensions: []*cloudwatch.Dimension{
+							{Name: aws.String("Realm"), Value: aws.String(ev.Realm)},
+						},
+						Value: aws.Float64(1.0),
+					},
+				},
+			})
+		}
+		return nil, "", &Failure{Event: ev, Reason: ReasonStatisticsFailed, Err: err}
+	}
+
+	missingPrivate := 0
+	for _, ship := range newData {
+		if ship.Private == nil {
+			missingPrivate++
+		}
+	}
+	if missingPrivate > 0 {
+		log.Printf("WARN: ships without private data accountId=%s ships=%d", ev.AccountID, missingPrivate)
+		limited = mergeLimited(limited, storage.LimitedMissingPrivate)
+	}
+
+	// Get all ships in port
+	shipsInPort, err := e.getPlayerPort(ctx, ev.Realm, ev.AccessToken, ev.AccountID)
+	portKnown := true
+	if err == api.ErrHiddenProfile || err == api.ErrNoPrivateData {
+		log.Printf("WARN: no port data, keeping garage state accountId=%s error=%v", ev.AccountID, err)
+		if err == api.ErrHiddenProfile {
+			limited = mergeLimited(limited, storage.LimitedHiddenProfile)
+		} else {
+			limited = mergeLimited(limited, storage.LimitedMissingPrivate)
+		}
+		shipsInPort, portKnown, err = nil, false, nil
+	}
+	if err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("GetPlayerPort failed")
+		log.Printf("ERROR: Could not retrieve ships in port accountId=%s error=%v", ev.AccountID, err)
+		return nil, "", &Failure{Event: ev, Reason: ReasonPortFailed, Err: err}
+	}
+
+	var pending []pendingEvent
+	subscriberData, err := storage.UpdatePublicSubscriberData(ctx, ev.AccountID, ev.DataURL, func(subscriberData *storage.SubscriberPublicData, isNew bool) error {
+		// The data may be updated several times on conflicts, every attempt starts from scratch
+		pending = e.apply(ctx, ev, subscriberData, isNew, copyStatistics(newData), shipsInPort, portKnown)
+		subscriberData.UpdateEarnedResources()
+		subscriberData.LastUpdated = time.Now().UnixNano()
+		subscriberData.SetLimited(limited, subscriberData.LastUpdated)
+		subscriberData.Realm = ev.Realm
+		return nil
+	})
+	if err != nil {
+		reason := ReasonSaveFailed
+		if uerr, ok := err.(*storage.UpdateError); ok {
+			if uerr.Op == "load" {
+				reason = ReasonLoadFailed
+			} else if uerr.Err == storage.ErrConflict {
+				reason = ReasonConflict
+			}
+		}
+
+		getHub(sentryAccountHub, E{"error": err.Error(), "reason": reason}).CaptureMessage("Could not update subscriber data")
+		log.Printf("ERROR: Could not update subscriber data: accountId=%s reason=%s error=%v", ev.AccountID, reason, err)
+		return nil, "", &Failure{Event: ev, Reason: reason, Err: err}
+	}
+
+	// Events are only sent once the data was saved, a retried update would send them twice otherwise
+	var earned []events.ResourceEarned
+	var addedShips, earnedShips []int64
+	batch := notify.Batch{AccountID: ev.AccountID}
+	var outgoing []webhooks.Event
+	for _, p := range pending {
+		if addition, ok := p.Event.(events.ShipAddition); !ok || !addition.Initial {
+			if event, ok := p.Event.(webhooks.Event); ok {
+				outgoing = append(outgoing, event)
+			}
+		}
+		switch event := p.Event.(type) {
+		case events.ResourceEarned:
+			earned = append(earned, event)
+			earnedShips = append(earnedShips, event.ShipID)
+			batch.ResourceEarned = append(batch.ResourceEarned, event)
+		case events.ShipAddition:
+			if !event.Initial {
+				addedShips = append(addedShips, event.ShipID)
+				batch.ShipAdditions = append(batch.ShipAdditions, event)
+			}
+		}
+
+		if err := events.Add(p.Event); err != nil {
+			sentryShipHub := sentryAccountHub.Clone()
+			sentryShipHub.ConfigureScope(func(scope *sentry.Scope) {
+				scope.SetTag("ShipID", fmt.Sprintf("%d", p.ShipID))
+			})
+
+			getHub(sentryShipHub, E{"error": err.Error()}).CaptureMessage(fmt.Sprintf("Could not send %s event", p.Name))
+			log.Printf("WARN: could not send %s event accountId=%s shipId=%d error=%v", p.Name, ev.AccountID, p.ShipID, err)
+		}
+	}
+
+	if err := storage.AppendHistory(ctx, subscriberData, addedShips, earnedShips); err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not append history")
+		log.Printf("WARN: could not append history accountId=%s error=%v", ev.AccountID, err)
+	}
+
+	e.notify(ctx, sentryAccountHub, batch)
+
+	outgoing = append(outgoing, events.NewRefreshCompleted(ev.AccountID, len(earned), subscriberData.LastUpdated))
+	if e.Webhooks != nil {
+		// Third party endpoints get their own short deadline, so that they can not use up the time of the account.
+		// Deliveries that were cut off are sent again from the delivery log.
+		webhookCtx, cancel := context.WithTimeout(context.Background(), e.WebhookTimeout)
+		err := e.Webhooks.Dispatch(webhookCtx, ev.AccountID, outgoing)
+		cancel()
+		if err != nil {
+			log.Printf("WARN: could not send webhooks accountId=%s error=%v", ev.AccountID, err)
+		}
+	}
+
+	if err := storage.SetSubscriberLastUpdated(ev.AccountID, subscriberData.LastUpdated, lastBattleTime(subscriberData)); err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not update LastUpdated in DynamoDB")
+		log.Printf("ERROR: Could not set last updated accountId=%s error=%v", ev.AccountID, err)
+	}
+
+	return earned, limited, nil
+}
+
+// mergeLimited returns the more important of two reasons for limited data, a hidden profile explains
+// missing private data as well
+func mergeLimited(current, reason string) string {
+	if current == storage.LimitedHiddenProfile {
+		return current
+	}
+	return reason
+}
+
+// notify sends the batch to the webhook registered by the subscriber, if there is one. With a notifications
+// publisher the batch is only published and delivered by the sendNotifications function, so that slow webhooks
+// and their retries do not hold up the refresh.
+func (e *Engine) notify(ctx context.Context, sentryAccountHub *sentry.Hub, batch notify.Batch) {
+	if e.Notify == nil || batch.Empty() {
+		return
+	}
+
+	registration, err := notify.GetRegistration(batch.AccountID)
+	if err != nil {
+		log.Printf("WARN: could not get notification registration accountId=%s error=%v", batch.AccountID, err)
+		return
+	}
+	if registration == nil {
+		return
+	}
+
+	if e.Notifications != nil {
+		body, err := json.Marshal(batch)
+		if err == nil {
+			err = e.Notifications.Publish(ctx, []queue.Message{{Lane: queue.Notification, Key: batch.AccountID, Body: body}})
+		}
+		if err != nil {
+			getHub(sentryAccountHub, E{"error": err.Error()}).CaptureMessage("Could not publish notification")
+			log.Printf("WARN: could not publish notification accountId=%s error=%v", batch.AccountID, err)
+		}
+		return
+	}
+
+	delivery, err := e.Notify.Dispatch(ctx, registration, batch)
+	if err != nil {
+		getHub(sentryAccountHub, E{"error": err.Error(), "kind": registration.Kind}).CaptureMessage("Could not send notification")
+		log.Printf("WARN: could not send notification accountId=%s error=%v", batch.AccountID, err)
+		return
+	}
+	if delivery != nil {
+		log.Printf("Sent notification accountId=%s kind=%s attempts=%d status=%d", batch.AccountID, registration.Kind, delivery.Attempts, delivery.StatusCode)
+	}
+}
+
+// lastBattleTime returns the time of the most recent battle of any ship of the subscriber
+func lastBattleTime(data *storage.SubscriberPublicData) int64 {
+	var last int64
+	for _, ship := range data.Ships {
+		if ship.ShipStatistics != nil && int64(ship.LastBattleTime) > last {
+			last = int64(ship.LastBattleTime)
+		}
+	}
+	return last
+}
+
+// pendingEvent is an event that is sent once the subscriber data was saved
+type pendingEvent struct {
+	ShipID int64
+	Name   string
+	Event  interface{}
+}
+
+// copyStatistics creates a shallow copy of the statistics, as apply adds ships to them
+func copyStatistics(statistics map[int64]*api.ShipStatistics) map[int64]*api.ShipStatistics {
+	c := make(map[int64]*api.ShipStatistics, len(statistics))
+	for id, ship := range statistics {
+		c[id] = ship
+	}
+	return c
+}
+
+// apply compares the new statistics of a subscriber with the stored data, credits resources for new battles
+// and returns the events that should be sent for the changes
+//
+// If portKnown is false, shipsInPort could not be read and the garage state of the stored ships is kept.
+func (e *Engine) apply(ctx context.Context, ev storage.RefreshEvent, subscriberData *storage.SubscriberPublicData, isNew bool, newData map[int64]*api.ShipStatistics, shipsInPort []int64, portKnown bool) []pendingEvent {
+	var pending []pendingEvent
+	creditedAt := time.Now().UnixNano()
+
+	// Remove ships if needed
+	if !isNew {
+		// Remove ships that are no longer in port
+		for _, storedShip := range subscriberData.Ships {
+			wowsShip, ok := catalogue.Default.Lookup(ctx, ev.Realm, storedShip.ShipID)
+			if !ok {
+				// Probably a ship that's not in the API anymore
+				continue
+			}
+
+			// Remove ships that are no longer eligible
+			if !wows.ActiveEvent.IsShipEligible(&wowsShip) {
+				if storedShip.ShipStatistics != nil && storedShip.Private != nil {
+					storedShip.Private.InGarage = false
+				}
+				delete(subscriberData.Ships, storedShip.ShipID)
+				log.Printf("Removed ineligible ship=%d player=%s", storedShip.ShipID, subscriberData.AccountID)
+
+				pending = append(pending, pendingEvent{
+					ShipID: storedShip.ShipID,
+					Name:   "ShipRemoval",
+					Event:  events.NewShipRemoval(ev.AccountID, ev.Realm, storedShip.ShipID, storedShip.Resource.Type, storedShip.Resource.Amount, storedShip.Resource.Earned),
+				})
+				continue
+			}
+
+			if portKnown && storedShip.ShipStatistics != nil && storedShip.Private != nil && storedShip.Private.InGarage {
+				found := false
+				for _, portShip := range shipsInPort {
+					if storedShip.ShipID == portShip {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					log.Printf("Ship removed from garage ship=%d player=%s", storedShip.ShipID, subscriberData.AccountID)
+					subscriberData.Ships[storedShip.ShipID].Private.InGarage = false
+
+					if newShip, isInStatistics := newData[storedShip.ShipID]; isInStatistics && newShip.Private != nil {
+						newShip.Private.InGarage = false
+					}
+					// sentryShipHub.CaptureMessage("ShipRemoval: no longer in garage")
+
+					// if err := events.Add(events.NewShipRemoval(ev.AccountID, storedShip.ShipID)); err != nil {
+					// 	getHub(sentryShipHub, E{"error": err.Error()}).CaptureMessage("Could not send ShipRemoval event")
+					// 	log.Printf("WARN: could not send event for removed subscriber ship error=%v", err)
+					// }
+				}
+			}
+		}
+	}
+
+	// Add ships that were not in port before
+	for _, shipID := range shipsInPort {
+		wowsShip, ok := catalogue.Default.Lookup(ctx, ev.Realm, shipID)
+		if !ok {
+			// Probably a ship that's not in the API anymore
+			continue
+		}
+
+		if !wows.ActiveEvent.IsShipEligible(&wowsShip) {
+			continue
+		}
+
+		// If the data is not in subscriberData yet, we did not refresh it the last time
+		if _, inCurrentData := subscriberData.Ships[shipID]; !inCurrentData {
+			// We want to ignore ships that also have new statistics, it means the ship was already
+			// played and will be processed further down.
+			if _, inNewData := newData[shipID]; inNewData {
+				continue
+			}
+		} else {
+			continue
+		}
+
+		log.Printf("New ship found from port data accountId=%s shipId=%d", ev.AccountID, shipID)
+
+		// Add the ship with empty data to newData,
+		// this means it will be counted as ShipAddition further down
+		newData[shipID] = &api.ShipStatistics{
+			ShipID:         shipID,
+			LastBattleTime: -1,
+			Private: &api.ShipStatisticsPrivate{
+				InGarage: true,
+			},
+		}
+	}
+
+	// Compare data
+	log.Printf("Received data: comparing accountId=%s", ev.AccountID)
+
+	for _, ship := range newData {
+		wowsShip, ok := catalogue.Default.Lookup(ctx, ev.Realm, ship.ShipID)
+		if !ok {
+			// Probably a ship that doesn't really exist anymore
+			continue
+		}
+		ship = keepPrivate(subscriberData.Ships[ship.ShipID], ship)
+
+		currentShip, ok := subscriberData.Ships[ship.ShipID]
+		if !ok {
+			if !wows.ActiveEvent.IsShipEligible(&wowsShip) {
+				continue
+			}
+
+			resourceType, amount := wows.ActiveEvent.GetShipRedeemable(&wowsShip)
+
+			// TODO: detect last battle time, set "Earned" automatically
+			currentShip = &storage.StoredShip{
+				ShipStatistics: ship,
+				Resource: storage.EarnableResource{
+					Type:   resourceType,
+					Amount: amount,
+					Earned: 0,
+				},
+			}
+
+			// The ships of new subscribers are only sent for statistics
+			addition := events.NewShipAddition(ev.AccountID, ev.Realm, ship.ShipID, resourceType, amount)
+			addition.Initial = isNew
+			pending = append(pending, pendingEvent{
+				ShipID: ship.ShipID,
+				Name:   "ShipAddition",
+				Event:  addition,
+			})
+
+			if ship.LastBattleTime > wows.EventStartTime[ev.Realm] {
+				// A battle was played with a ship that we did not know yet.
+				// For new subscribers, they might be coming to the event late.
+				// For existing subscribers, they might just have bought a ship and played a battle
+				// with it. Let's give them the resource if we can find any wins.
+
+				// Compare against empty statistics to find a win
+				_, winType := getWinType(&storage.StoredShip{
+					ShipStatistics: &api.ShipStatistics{},
+				}, ship)
+
+				// if win {
+				// Credit the resources
+				currentShip.ShipStatistics = ship
+				currentShip.CreditWith(winType, creditedAt, int64(ship.LastBattleTime))
+				subscriberData.Ships[ship.ShipID] = currentShip
+
+				pending = append(pending, pendingEvent{
+					ShipID: currentShip.ShipID,
+					Name:   "ResourceEarned",
+					Event:  events.NewResourceEarned(ev.AccountID, ev.Realm, currentShip.Resource.Type, currentShip.Resource.Amount, currentShip.ShipID, winType),
+				})
+				continue
+				// }
+			}
+		}
+
+		if !wows.ActiveEvent.IsShipEligible(&wowsShip) {
+			// remove the ship
+			delete(subscriberData.Ships, ship.ShipID)
+			log.Printf("Removed uneligible ship accountId=%s shipId=%d", ev.AccountID, ship.ShipID)
+
+			continue
+		}
+
+		if currentShip.Resource.Earned > 0 {
+			// Ships marked as played are checked against the statistics, the battle type is only known when confirmed
+			_, winType := getWinType(currentShip, ship)
+			if currentShip.CheckManualCredit(int64(ship.LastBattleTime), int64(wows.EventStartTime[ev.Realm]), winType, time.Unix(0, creditedAt)) {
+				log.Printf("Manual credit checked accountId=%s shipId=%d status=%s", ev.AccountID, ship.ShipID, currentShip.ManualCredit.Status)
+			}
+
+			// Skip already earned ship
+			currentShip.ShipStatistics = ship
+			subscriberData.Ships[ship.ShipID] = currentShip
+			continue
+		}
+
+		if ship.LastBattleTime != -1 && ship.LastBattleTime > currentShip.LastBattleTime && ship.LastBattleTime > wows.EventStartTime[ev.Realm] {
+			// There is a new battle. Find out if it was a win and credit resources
+
+			// Snowflake 2020: removed win condiiton (need 300 base xp, let's just assume people are not this bad)
+			_, winType := getWinType(currentShip, ship)
+
+			// if win {
+			currentShip.ShipStatistics = ship
+			currentShip.CreditWith(winType, creditedAt, int64(ship.LastBattleTime))
+
+			pending = append(pending, pendingEvent{
+				ShipID: currentShip.ShipID,
+				Name:   "ResourceEarned",
+				Event:  events.NewResourceEarned(ev.AccountID, ev.Realm, currentShip.Resource.Type, currentShip.Resource.Amount, currentShip.ShipID, winType),
+			})
+			// }
+		}
+
+		currentShip.ShipStatistics = ship
+		subscriberData.Ships[ship.ShipID] = currentShip
+	}
+
+	return pending
+}
+
+// keepPrivate returns the statistics with the private data of the stored ship if the API returned none.
+// The statistics are copied, as they are shared between attempts of the update.
+func keepPrivate(stored *storage.StoredShip, ship *api.ShipStatistics) *api.ShipStatistics {
+	if ship.Private != nil || stored == nil || stored.ShipStatistics == nil || stored.Private == nil {
+		return ship
+	}
+
+	withPrivate := *ship
+	private := *stored.Private
+	withPrivate.Private = &private
+	return &withPrivate
+}
+
+func (e *Engine) refreshAccessToken(ctx context.Context, realm, accessToken, accountID string) (*api.RefreshAccessTokenResponse, error) {
+	if err := e.Limiter.Wait(ctx, realm); err != nil {
+		return nil, err
+	}
+	return api.RefreshAccessToken(ctx, realm, accessToken, accountID)
+}
+
+func (e *Engine) getPlayerShipStatistics(ctx context.Context, realm, accessToken, accountID string) (map[int64]*api.ShipStatistics, error) {
+	if err := e.Limiter.Wait(ctx, realm); err != nil {
+		return nil, err
+	}
+	return api.GetPlayerShipStatistics(ctx, realm, accessToken, accountID)
+}
+
+func (e *Engine) getPlayerPort(ctx context.Context, realm, accessToken, accountID string) ([]int64, error) {
+	if err := e.Limiter.Wait(ctx, realm); err != nil {
+		return nil, err
+	}
+	return api.GetPlayerPort(ctx, realm, accessToken, accountID)
+}
+func getWinType(currentShip *storage.StoredShip, newShip *api.ShipStatistics) (win bool, winType string) {
+	if newShip.Pvp.Wins > currentShip.Pvp.Wins {
+		win = true
+		winType = "pvp"
+	} else if newShip.Pve.Wins > currentShip.Pve.Wins {
+		win = true
+		winType = "pve"
+	} else if newShip.OperDiv.Wins > currentShip.OperDiv.Wins {
+		win = true
+		winType = "oper_div"
+	} else if newShip.OperSolo.Wins > currentShip.OperSolo.Wins {
+		win = true
+		winType = "oper_solo"
+	} else if newShip.RankSolo.Wins > currentShip.RankSolo.Wins {
+		win = true
+		winType = "rank_solo"
+	}
+	return win, winType
+}
+
+func accessTokenExpiresSoon(expiresAt int64) bool {
+	now := time.Now().Unix()
+
+	if expiresAt-now < 24*60*60 {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package refresh
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RealmLimiter spaces out requests to the Wargaming API. Every realm has its own API cluster
+// and therefore its own limit.
+type RealmLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+}
+
+// NewRealmLimiter creates a limiter that allows the given number of requests per second and realm
+func NewRealmLimiter(requestsPerSecond int) *RealmLimiter {
+	if requestsPerSecond < 1 {
+		requestsPerSecond = 1
+	}
+
+	return &RealmLimiter{
+		interval: time.Second / time.Duration(requestsPerSecond),
+		next:     map[string]time.Time{},
+	}
+}
+
+// Wait blocks until a request to the realm is allowed or the context is done
+func (l *RealmLimiter) Wait(ctx context.Context, realm string) error {
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next[realm]
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next[realm] = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,257 @@
+package refresh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"rukenshia/frenchwhaling/pkg/push"
+	"rukenshia/frenchwhaling/pkg/queue"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/webhooks"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/gammazero/workerpool"
+	"github.com/getsentry/sentry-go"
+)
+
+// Reason describes why an account was skipped or could not be refreshed
+type Reason string
+
+const (
+	ReasonInvalidRealm     Reason = "invalid_realm"
+	ReasonCancelled        Reason = "cancelled"
+	ReasonTimeout          Reason = "timeout"
+	ReasonLoadFailed       Reason = "load_failed"
+	ReasonStatisticsFailed Reason = "statistics_failed"
+	ReasonPortFailed       Reason = "port_failed"
+	ReasonSaveFailed       Reason = "save_failed"
+	ReasonConflict         Reason = "conflict"
+)
+
+// isSkip returns whether the reason means that the account was not processed at all
+func (r Reason) isSkip() bool {
+	return r == ReasonInvalidRealm || r == ReasonCancelled
+}
+
+// Failure is returned for every account that was skipped or could not be refreshed
+type Failure struct {
+	Event  storage.RefreshEvent
+	Reason Reason
+	Err    error
+}
+
+func (f *Failure) Error() string {
+	return fmt.Sprintf("refresh of account %s failed (%s): %v", f.Event.AccountID, f.Reason, f.Err)
+}
+
+// Summary is the result of a batch of refreshes
+type Summary struct {
+	Processed int
+	Skipped   map[Reason]int
+	Failed    map[Reason]int
+	Failures  []*Failure
+	Duration  time.Duration
+}
+
+func (s *Summary) String() string {
+	return fmt.Sprintf("processed=%d skipped=%d%s failed=%d%s duration=%s",
+		s.Processed, count(s.Skipped), formatReasons(s.Skipped), count(s.Failed), formatReasons(s.Failed), s.Duration)
+}
+
+func count(reasons map[Reason]int) int {
+	total := 0
+	for _, n := range reasons {
+		total += n
+	}
+	return total
+}
+
+func formatReasons(reasons map[Reason]int) string {
+	if len(reasons) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for reason, n := range reasons {
+		parts = append(parts, fmt.Sprintf("%s:%d", reason, n))
+	}
+	sort.Strings(parts)
+	return "(" + strings.Join(parts, ",") + ")"
+}
+
+// Engine refreshes the data of subscribers
+//
+// Accounts are processed concurrently, while all calls to the Wargaming API go through a limiter shared
+// by all workers, as the API only allows a certain amount of requests per second.
+type Engine struct {
+	// Concurrency is the number of accounts that are processed at the same time
+	Concurrency int
+	// AccountTimeout is the time a single account may take before it is cancelled
+	AccountTimeout time.Duration
+	// Limiter limits the requests to the Wargaming API
+	Limiter *RealmLimiter
+	// Push sends the progress to the browsers of the subscribers, it is nil when there is no WebSocket API
+	Push *push.Client
+	// Notify sends earned resources and new ships to the webhooks registered by subscribers
+	Notify *notify.Dispatcher
+	// Notifications publishes the notifications to the sendNotifications function instead of sending them
+	// as part of the refresh, it is nil when NOTIFICATIONS_TOPIC_ARN is not set
+	Notifications queue.Publisher
+	// Webhooks sends the events of a refresh to the endpoints registered through the webhook API
+	Webhooks *webhooks.Dispatcher
+	// WebhookTimeout is the time the webhooks of an account may take, independent of AccountTimeout
+	WebhookTimeout time.Duration
+
+	cloudwatch *cloudwatch.CloudWatch
+}
+
+// NewEngine creates an engine configured through the environment:
+//
+// REFRESH_CONCURRENCY (default 4), REFRESH_ACCOUNT_TIMEOUT in seconds (default 30) and
+// WG_REQUESTS_PER_SECOND per realm (default 4). Notifications are published to NOTIFICATIONS_TOPIC_ARN if it is set.
+//
+// The ship catalogue is refreshed through the limiter of the engine.
+func NewEngine() *Engine {
+	limiter := NewRealmLimiter(envInt("WG_REQUESTS_PER_SECOND", 4))
+	catalogue.Default.Wait = limiter.Wait
+
+	var notifications queue.Publisher
+	if topic := os.Getenv("NOTIFICATIONS_TOPIC_ARN"); topic != "" {
+		notifications = queue.NewSNS(topic)
+	}
+
+	return &Engine{
+		Concurrency:    envInt("REFRESH_CONCURRENCY", 4),
+		AccountTimeout: time.Duration(envInt("REFRESH_ACCOUNT_TIMEOUT", 30)) * time.Second,
+		Limiter:        limiter,
+		Push:           push.NewClient(),
+		Notify:         notify.NewDispatcher(),
+		Notifications:  notifications,
+		Webhooks:       webhooks.NewDispatcher(),
+		WebhookTimeout: 5 * time.Second,
+		cloudwatch:     cloudwatch.New(session.Must(session.NewSession())),
+	}
+}
+
+func envInt(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value < 1 {
+		return fallback
+	}
+	return value
+}
+
+// Run refreshes all accounts and returns once every account was either processed or skipped.
+// Accounts that have not been started when the context is done are skipped.
+func (e *Engine) Run(ctx context.Context, refreshEvents []storage.RefreshEvent) *Summary {
+	start := time.Now()
+	summary := &Summary{
+		Skipped: map[Reason]int{},
+		Failed:  map[Reason]int{},
+	}
+	var mu sync.Mutex
+
+	workers := workerpool.New(e.Concurrency)
+	for _, ev := range refreshEvents {
+		ev := ev
+		workers.Submit(func() {
+			err := e.refreshAccount(ctx, ev)
+			// Only events sent from the retry table have a retry to delete, other refreshes leave a waiting
+			// retry alone, which then succeeds and deletes itself
+			if err == nil && ev.Attempt > 0 {
+				if err := storage.DeleteRetry(ev.AccountID); err != nil {
+					log.Printf("ERROR: could not delete retry accountId=%s error=%v", ev.AccountID, err)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				summary.Processed++
+				return
+			}
+
+			failure, ok := err.(*Failure)
+			if !ok {
+				failure = &Failure{Event: ev, Reason: ReasonLoadFailed, Err: err}
+			}
+
+			if failure.Reason.isSkip() {
+				summary.Skipped[failure.Reason]++
+			} else {
+				summary.Failed[failure.Reason]++
+			}
+			summary.Failures = append(summary.Failures, failure)
+		})
+	}
+	workers.StopWait()
+
+	summary.Duration = time.Since(start)
+	return summary
+}
+
+// refreshAccount runs the refresh of a single account with its own timeout
+func (e *Engine) refreshAccount(ctx context.Context, ev storage.RefreshEvent) error {
+	if err := ctx.Err(); err != nil {
+		log.Printf("WARN: batch cancelled, skipping accountId=%s", ev.AccountID)
+		return &Failure{Event: ev, Reason: ReasonCancelled, Err: err}
+	}
+
+	accountCtx, cancel := context.WithTimeout(ctx, e.AccountTimeout)
+	defer cancel()
+
+	sentryAccountHub := sentry.CurrentHub().Clone()
+	sentryAccountHub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTag("AccountID", ev.AccountID)
+	})
+
+	connections, err := e.Push.Connections(ev.AccountID)
+	if err != nil {
+		log.Printf("WARN: could not get push connections accountId=%s error=%v", ev.AccountID, err)
+	}
+	e.Push.Send(ctx, connections, push.NewMessage(push.RefreshStarted, ev.AccountID))
+
+	earned, limited, err := e.process(accountCtx, sentryAccountHub, ev)
+	if err != nil && accountCtx.Err() == context.DeadlineExceeded {
+		getHub(sentryAccountHub, E{"error": err.Error(), "timeout": e.AccountTimeout.String()}).CaptureMessage("Refresh timed out")
+		log.Printf("ERROR: refresh timed out accountId=%s timeout=%s", ev.AccountID, e.AccountTimeout)
+		err = &Failure{Event: ev, Reason: ReasonTimeout, Err: err}
+	}
+
+	// The account context may be done already, the result is still worth sending
+	if err != nil {
+		message := push.NewMessage(push.RefreshFailed, ev.AccountID)
+		if failure, ok := err.(*Failure); ok {
+			message.Reason = string(failure.Reason)
+		}
+		e.Push.Send(context.Background(), connections, message)
+		return err
+	}
+
+	message := push.NewMessage(push.RefreshCompleted, ev.AccountID)
+	message.Earned = earned
+	message.Limited = limited
+	e.Push.Send(context.Background(), connections, message)
+	return nil
+}
+
+// E is a shorthand for extra fields attached to sentry events
+type E map[string]interface{}
+
+func getHub(hub *sentry.Hub, fields map[string]interface{}) *sentry.Hub {
+	h := hub.Clone()
+	h.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetExtras(fields)
+	})
+	return h
+}
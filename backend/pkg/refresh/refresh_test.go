@@ -0,0 +1,93 @@
+package refresh
+
+import (
+	"context"
+	"errors"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"testing"
+	"time"
+)
+
+func TestRunSkipsCancelledAccounts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	e := &Engine{Concurrency: 2, AccountTimeout: time.Second}
+	summary := e.Run(ctx, []storage.RefreshEvent{{AccountID: "1"}, {AccountID: "2"}, {AccountID: "3"}})
+
+	if summary.Processed != 0 || summary.Skipped[ReasonCancelled] != 3 || len(summary.Failed) != 0 {
+		t.Errorf("summary = %s, want 3 cancelled accounts", summary)
+	}
+	if len(summary.Failures) != 3 {
+		t.Fatalf("got %d failures, want 3", len(summary.Failures))
+	}
+	for _, failure := range summary.Failures {
+		if failure.Retryable() {
+			t.Errorf("cancelled account %s is retryable", failure.Event.AccountID)
+		}
+	}
+}
+
+func TestSummaryString(t *testing.T) {
+	summary := &Summary{
+		Processed: 5,
+		Skipped:   map[Reason]int{ReasonCancelled: 2},
+		Failed:    map[Reason]int{ReasonTimeout: 1, ReasonConflict: 3},
+		Duration:  2 * time.Second,
+	}
+
+	expected := "processed=5 skipped=2(cancelled:2) failed=4(conflict:3,timeout:1) duration=2s"
+	if s := summary.String(); s != expected {
+		t.Errorf("String() = %q, want %q", s, expected)
+	}
+
+	empty := &Summary{Skipped: map[Reason]int{}, Failed: map[Reason]int{}}
+	if s := empty.String(); s != "processed=0 skipped=0 failed=0 duration=0s" {
+		t.Errorf("String() = %q for an empty summary", s)
+	}
+}
+
+func TestFailureRetryable(t *testing.T) {
+	tests := []struct {
+		reason    Reason
+		err       error
+		retryable bool
+	}{
+		{ReasonInvalidRealm, errors.New("invalid realm"), false},
+		{ReasonCancelled, context.Canceled, false},
+		{ReasonTimeout, context.DeadlineExceeded, true},
+		{ReasonLoadFailed, errors.New("load"), true},
+		{ReasonStatisticsFailed, errors.New("request failed"), true},
+		{ReasonStatisticsFailed, errors.New("INVALID_ACCESS_TOKEN"), false},
+		{ReasonPortFailed, errors.New("port"), true},
+		{ReasonSaveFailed, errors.New("save"), true},
+		{ReasonConflict, storage.ErrConflict, true},
+		{ReasonSaveFailed, nil, true},
+	}
+
+	for _, tt := range tests {
+		failure := &Failure{Reason: tt.reason, Err: tt.err}
+		if retryable := failure.Retryable(); retryable != tt.retryable {
+			t.Errorf("Retryable() = %t for %s (%v), want %t", retryable, tt.reason, tt.err, tt.retryable)
+		}
+	}
+}
+
+func TestMergeLimited(t *testing.T) {
+	tests := []struct {
+		current, reason, expected string
+	}{
+		{"", storage.LimitedMissingPrivate, storage.LimitedMissingPrivate},
+		{"", storage.LimitedHiddenProfile, storage.LimitedHiddenProfile},
+		{storage.LimitedMissingPrivate, storage.LimitedMissingPrivate, storage.LimitedMissingPrivate},
+		{storage.LimitedMissingPrivate, storage.LimitedHiddenProfile, storage.LimitedHiddenProfile},
+		{storage.LimitedHiddenProfile, storage.LimitedMissingPrivate, storage.LimitedHiddenProfile},
+		{storage.LimitedHiddenProfile, storage.LimitedHiddenProfile, storage.LimitedHiddenProfile},
+	}
+
+	for _, tt := range tests {
+		if merged := mergeLimited(tt.current, tt.reason); merged != tt.expected {
+			t.Errorf("mergeLimited(%q, %q) = %q, want %q", tt.current, tt.reason, merged, tt.expected)
+		}
+	}
+}
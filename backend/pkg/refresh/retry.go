@@ -0,0 +1,57 @@
+package refresh
+
+import (
+	"log"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// Retryable returns whether trying the refresh again later could succeed
+func (f *Failure) Retryable() bool {
+	// Skipped accounts were not processed at all, they are refreshed again by the next schedule
+	if f.Reason.isSkip() {
+		return false
+	}
+
+	// The subscriber was disabled, there is no point in trying again until they log in again
+	if f.Err != nil && strings.Contains(f.Err.Error(), "INVALID_ACCESS_TOKEN") {
+		return false
+	}
+	return true
+}
+
+// ScheduleRetries stores every retryable failure of the summary in the retry queue. Failures that
+// ran out of attempts are moved to the dead-letter table.
+func ScheduleRetries(hub *sentry.Hub, summary *Summary) {
+	retried, deadLettered := 0, 0
+	for _, failure := range summary.Failures {
+		if !failure.Retryable() {
+			continue
+		}
+
+		dead, err := storage.ScheduleRetry(failure.Event, string(failure.Reason), failure.Err)
+		if err != nil {
+			getHub(hub, E{"error": err.Error(), "accountId": failure.Event.AccountID}).CaptureMessage("Could not schedule retry")
+			log.Printf("ERROR: could not schedule retry accountId=%s error=%v", failure.Event.AccountID, err)
+			continue
+		}
+
+		if dead {
+			deadLettered++
+			getHub(hub, E{
+				"accountId": failure.Event.AccountID,
+				"reason":    failure.Reason,
+				"error":     failure.Err.Error(),
+				"attempt":   failure.Event.Attempt + 1,
+			}).CaptureMessage("Refresh dead-lettered")
+		} else {
+			retried++
+		}
+	}
+
+	if retried > 0 || deadLettered > 0 {
+		log.Printf("Scheduled failed refreshes retried=%d deadLettered=%d", retried, deadLettered)
+	}
+}
@@ -0,0 +1,104 @@
+// Package schedule decides how often the data of a subscriber is refreshed.
+//
+// Players that are currently playing are refreshed often, players that have not played for a while only once a day.
+// After the event has ended, every subscriber gets one last refresh, after which they are not refreshed anymore.
+package schedule
+
+import (
+	"fmt"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"time"
+)
+
+var (
+	// ActiveInterval is used for players that played within ActiveWithin
+	ActiveInterval = 4 * time.Hour
+	// NormalInterval is used for players that played within DormantAfter and new subscribers
+	NormalInterval = 8 * time.Hour
+	// DormantInterval is used for players that have not played for DormantAfter
+	DormantInterval = 24 * time.Hour
+
+	ActiveWithin = 48 * time.Hour
+	DormantAfter = 7 * 24 * time.Hour
+)
+
+// Activity is what is known about a subscriber when scheduling
+type Activity struct {
+	Realm string
+	// LastBattleTime is the Unix timestamp of the last battle seen by the refresh
+	LastBattleTime int64
+	// LastEarned is the time of the last ResourceEarned event in nanoseconds
+	LastEarned int64
+	// LastScheduled is the time of the last refresh in nanoseconds
+	LastScheduled int64
+}
+
+// LastActive returns the time the player was last seen playing, or the zero time if that is not known
+func (a Activity) LastActive() time.Time {
+	var last time.Time
+	if a.LastBattleTime > 0 {
+		last = time.Unix(a.LastBattleTime, 0)
+	}
+	if a.LastEarned > 0 {
+		if earned := time.Unix(0, a.LastEarned); earned.After(last) {
+			last = earned
+		}
+	}
+	return last
+}
+
+// Decision is the outcome of Decide
+type Decision struct {
+	Refresh bool
+	// Interval is the time between two refreshes of the subscriber, 0 if they are not refreshed anymore
+	Interval time.Duration
+	Reason   string
+}
+
+// Decide returns whether the subscriber should be refreshed now
+func Decide(now time.Time, a Activity) Decision {
+	lastScheduled := time.Unix(0, a.LastScheduled)
+	lastActive := a.LastActive()
+
+	if end, ok := wows.EventEndTime[a.Realm]; ok && now.Unix() > int64(end) {
+		eventEnd := time.Unix(int64(end), 0)
+
+		if a.LastScheduled > 0 && !lastScheduled.Before(eventEnd) {
+			return Decision{Reason: "event ended, final refresh done"}
+		}
+		if !lastActive.IsZero() && eventEnd.Sub(lastActive) > DormantAfter {
+			return Decision{Reason: fmt.Sprintf("event ended, dormant since %s", lastActive.UTC().Format(time.RFC3339))}
+		}
+		return Decision{Refresh: true, Reason: "event ended, final refresh"}
+	}
+
+	var d Decision
+	switch {
+	case lastActive.IsZero():
+		d = Decision{Interval: NormalInterval, Reason: "no activity known"}
+	case now.Sub(lastActive) <= ActiveWithin:
+		d = Decision{Interval: ActiveInterval, Reason: fmt.Sprintf("active, last played %s ago", now.Sub(lastActive).Round(time.Minute))}
+	case now.Sub(lastActive) <= DormantAfter:
+		d = Decision{Interval: NormalInterval, Reason: fmt.Sprintf("last played %s ago", now.Sub(lastActive).Round(time.Minute))}
+	default:
+		d = Decision{Interval: DormantInterval, Reason: fmt.Sprintf("dormant, last played %s ago", now.Sub(lastActive).Round(time.Hour))}
+	}
+
+	d.Refresh = now.Sub(lastScheduled) >= d.Interval
+	return d
+}
+
+// NeedsEventHistory returns whether the ResourceEarned events could change the decision. LastBattleTime is
+// already stored on the subscriber, so the events are only looked at when it does not lead to a refresh.
+//
+// Dormant subscribers that were refreshed within DormantInterval are not looked at either: the lookup would cost
+// a query for each of them on every run, while their next refresh is at most DormantInterval away.
+func NeedsEventHistory(now time.Time, a Activity, d Decision) bool {
+	if d.Refresh || a.LastEarned != 0 {
+		return false
+	}
+	if d.Interval == DormantInterval && now.Sub(time.Unix(0, a.LastScheduled)) < DormantInterval {
+		return false
+	}
+	return a.LastBattleTime == 0 || now.Sub(time.Unix(a.LastBattleTime, 0)) > ActiveWithin
+}
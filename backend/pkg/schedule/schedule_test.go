@@ -0,0 +1,186 @@
+package schedule
+
+import (
+	"rukenshia/frenchwhaling/pkg/wows"
+	"testing"
+	"time"
+)
+
+func TestDecide(t *testing.T) {
+	now := time.Date(2021, 12, 20, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) int64 {
+		return now.Add(-d).Unix()
+	}
+	scheduledAgo := func(d time.Duration) int64 {
+		return now.Add(-d).UnixNano()
+	}
+
+	tests := []struct {
+		name     string
+		activity Activity
+		refresh  bool
+		interval time.Duration
+	}{
+		{
+			name:     "new subscriber",
+			activity: Activity{},
+			refresh:  true,
+			interval: NormalInterval,
+		},
+		{
+			name:     "no activity known, refreshed within the normal interval",
+			activity: Activity{LastScheduled: scheduledAgo(NormalInterval - time.Minute)},
+			interval: NormalInterval,
+		},
+		{
+			name:     "active, due",
+			activity: Activity{LastBattleTime: ago(time.Hour), LastScheduled: scheduledAgo(ActiveInterval)},
+			refresh:  true,
+			interval: ActiveInterval,
+		},
+		{
+			name:     "active, not due",
+			activity: Activity{LastBattleTime: ago(time.Hour), LastScheduled: scheduledAgo(ActiveInterval - time.Minute)},
+			interval: ActiveInterval,
+		},
+		{
+			name:     "active at the boundary",
+			activity: Activity{LastBattleTime: ago(ActiveWithin), LastScheduled: scheduledAgo(ActiveInterval)},
+			refresh:  true,
+			interval: ActiveInterval,
+		},
+		{
+			name:     "normal just after the active boundary",
+			activity: Activity{LastBattleTime: ago(ActiveWithin + time.Second), LastScheduled: scheduledAgo(ActiveInterval)},
+			interval: NormalInterval,
+		},
+		{
+			name:     "normal, due",
+			activity: Activity{LastBattleTime: ago(72 * time.Hour), LastScheduled: scheduledAgo(NormalInterval)},
+			refresh:  true,
+			interval: NormalInterval,
+		},
+		{
+			name:     "normal at the dormant boundary",
+			activity: Activity{LastBattleTime: ago(DormantAfter), LastScheduled: scheduledAgo(NormalInterval)},
+			refresh:  true,
+			interval: NormalInterval,
+		},
+		{
+			name:     "dormant just after the boundary",
+			activity: Activity{LastBattleTime: ago(DormantAfter + time.Second), LastScheduled: scheduledAgo(NormalInterval)},
+			interval: DormantInterval,
+		},
+		{
+			name:     "dormant, due",
+			activity: Activity{LastBattleTime: ago(30 * 24 * time.Hour), LastScheduled: scheduledAgo(DormantInterval)},
+			refresh:  true,
+			interval: DormantInterval,
+		},
+		{
+			name:     "an earned resource is newer than the last battle",
+			activity: Activity{LastBattleTime: ago(30 * 24 * time.Hour), LastEarned: scheduledAgo(time.Hour), LastScheduled: scheduledAgo(ActiveInterval)},
+			refresh:  true,
+			interval: ActiveInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Decide(now, tt.activity)
+			if d.Refresh != tt.refresh || d.Interval != tt.interval {
+				t.Errorf("Decide() = %+v, want refresh=%t interval=%s", d, tt.refresh, tt.interval)
+			}
+		})
+	}
+}
+
+func TestDecideAfterEventEnd(t *testing.T) {
+	end := time.Unix(int64(wows.EventEndTime["eu"]), 0)
+	now := end.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		activity Activity
+		refresh  bool
+	}{
+		{
+			name:     "final refresh",
+			activity: Activity{Realm: "eu", LastBattleTime: end.Add(-time.Hour).Unix(), LastScheduled: end.Add(-time.Minute).UnixNano()},
+			refresh:  true,
+		},
+		{
+			name:     "final refresh done",
+			activity: Activity{Realm: "eu", LastBattleTime: end.Add(-time.Hour).Unix(), LastScheduled: end.UnixNano()},
+		},
+		{
+			name:     "dormant before the end",
+			activity: Activity{Realm: "eu", LastBattleTime: end.Add(-DormantAfter - time.Second).Unix(), LastScheduled: end.Add(-time.Hour).UnixNano()},
+		},
+		{
+			name:     "never scheduled",
+			activity: Activity{Realm: "eu"},
+			refresh:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Decide(now, tt.activity)
+			if d.Refresh != tt.refresh || d.Interval != 0 {
+				t.Errorf("Decide() = %+v, want refresh=%t without interval", d, tt.refresh)
+			}
+		})
+	}
+}
+
+func TestNeedsEventHistory(t *testing.T) {
+	now := time.Date(2021, 12, 20, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) int64 {
+		return now.Add(-d).Unix()
+	}
+	scheduledAgo := func(d time.Duration) int64 {
+		return now.Add(-d).UnixNano()
+	}
+
+	tests := []struct {
+		name     string
+		activity Activity
+		want     bool
+	}{
+		{
+			name:     "refreshed anyway",
+			activity: Activity{LastBattleTime: ago(time.Hour), LastScheduled: scheduledAgo(ActiveInterval)},
+		},
+		{
+			name:     "active by the last battle",
+			activity: Activity{LastBattleTime: ago(time.Hour), LastScheduled: scheduledAgo(time.Hour)},
+		},
+		{
+			name:     "no activity known",
+			activity: Activity{LastScheduled: scheduledAgo(ActiveInterval)},
+			want:     true,
+		},
+		{
+			name:     "normal",
+			activity: Activity{LastBattleTime: ago(72 * time.Hour), LastScheduled: scheduledAgo(ActiveInterval)},
+			want:     true,
+		},
+		{
+			name:     "dormant within the dormant interval",
+			activity: Activity{LastBattleTime: ago(30 * 24 * time.Hour), LastScheduled: scheduledAgo(DormantInterval - time.Minute)},
+		},
+		{
+			name:     "last earned already known",
+			activity: Activity{LastBattleTime: ago(72 * time.Hour), LastEarned: scheduledAgo(72 * time.Hour), LastScheduled: scheduledAgo(ActiveInterval)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsEventHistory(now, tt.activity, Decide(now, tt.activity)); got != tt.want {
+				t.Errorf("NeedsEventHistory() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/catalogue"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// Dimensions of the counters
+const (
+	DimensionResource = "resource"
+	DimensionTier     = "tier"
+	DimensionNation   = "nation"
+	DimensionShip     = "ship"
+)
+
+// GlobalScope is the scope of the counters of all realms
+const GlobalScope = "global"
+
+// Key identifies a counter, for example the ships of tier 8 that can earn coal in the eu realm
+type Key struct {
+	// Scope is GlobalScope or a realm
+	Scope     string
+	Dimension string
+	// Value is the tier, nation or ship. It is empty for DimensionResource.
+	Value    string
+	Resource wows.Resource
+}
+
+func (k Key) String() string {
+	return strings.Join([]string{k.Scope, k.Dimension, k.Value, k.Resource.ID()}, "#")
+}
+
+// ParseKey parses a key created by Key.String
+func ParseKey(s string) (Key, error) {
+	parts := strings.Split(s, "#")
+	if len(parts) != 4 {
+		return Key{}, fmt.Errorf("invalid counter key %s", s)
+	}
+
+	resource, err := wows.ParseResource(parts[3])
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{Scope: parts[0], Dimension: parts[1], Value: parts[2], Resource: resource}, nil
+}
+
+// Counter is the value of a counter, or a change of it. Values can be negative while events arrive out of order.
+type Counter struct {
+	Ships       int64
+	EarnedShips int64
+	Amount      int64
+	Earned      int64
+}
+
+// IsZero returns whether nothing is counted
+func (c Counter) IsZero() bool {
+	return c == Counter{}
+}
+
+func (c Counter) sub(o Counter) Counter {
+	return Counter{
+		Ships:       c.Ships - o.Ships,
+		EarnedShips: c.EarnedShips - o.EarnedShips,
+		Amount:      c.Amount - o.Amount,
+		Earned:      c.Earned - o.Earned,
+	}
+}
+
+// Count converts the counter for the statistics document, negative values are counted as 0
+func (c Counter) Count() Count {
+	positive := func(v int64) int64 {
+		if v < 0 {
+			return 0
+		}
+		return v
+	}
+	return Count{
+		Ships:       int(positive(c.Ships)),
+		EarnedShips: int(positive(c.EarnedShips)),
+		Amount:      uint(positive(c.Amount)),
+		Earned:      uint(positive(c.Earned)),
+	}
+}
+
+// Counters are counters by key
+type Counters map[Key]Counter
+
+// Add adds the change to the counter of the key
+func (c Counters) Add(key Key, change Counter) {
+	counter := c[key]
+	counter.Ships += change.Ships
+	counter.EarnedShips += change.EarnedShips
+	counter.Amount += change.Amount
+	counter.Earned += change.Earned
+	c[key] = counter
+}
+
+// AddShip adds the change to all counters of a ship in the global scope and the scope of the realm.
+// Tiers and nations are only counted for ships that are in the catalogue.
+func (c Counters) AddShip(realm string, shipID int64, resource wows.Resource, change Counter) {
+	if realm == "" {
+		realm = UnknownRealm
+	}
+
+	for _, scope := range []string{GlobalScope, realm} {
+		c.Add(Key{Scope: scope, Dimension: DimensionResource, Resource: resource}, change)
+		c.Add(Key{Scope: scope, Dimension: DimensionShip, Value: strconv.FormatInt(shipID, 10), Resource: resource}, change)
+
+		warship, ok := catalogue.Default.Get(shipID)
+		if !ok {
+			continue
+		}
+		c.Add(Key{Scope: scope, Dimension: DimensionTier, Value: strconv.Itoa(warship.Tier), Resource: resource}, change)
+		c.Add(Key{Scope: scope, Dimension: DimensionNation, Value: warship.Nation, Resource: resource}, change)
+	}
+}
+
+// AddData counts all ships of a subscriber
+func (c Counters) AddData(data *storage.SubscriberPublicData) {
+	for _, ship := range data.Ships {
+		change := Counter{Ships: 1, Amount: int64(ship.Resource.Amount), Earned: int64(ship.Resource.Earned)}
+		if ship.Resource.Earned > 0 {
+			change.EarnedShips = 1
+		}
+		c.AddShip(data.Realm, ship.ShipID, ship.Resource.Type, change)
+	}
+}
+
+// AddEvent counts the change described by an event. It returns false for events that do not change the counters,
+// which includes ship additions and removals that were stored before they had a resource.
+func (c Counters) AddEvent(event interface{}) bool {
+	realm := func(e events.SubscriberEvent) string {
+		if e.Realm != "" {
+			return e.Realm
+		}
+		accountID, _ := strconv.ParseInt(e.AccountID, 10, 64)
+		return wows.RealmFromAccountID(accountID)
+	}
+
+	switch e := event.(type) {
+	case events.ResourceEarned:
+		c.AddShip(realm(e.SubscriberEvent), e.ShipID, e.Resource, Counter{EarnedShips: 1, Earned: int64(e.Amount)})
+	case events.ResourceRevoked:
+		c.AddShip(realm(e.SubscriberEvent), e.ShipID, e.Resource, Counter{EarnedShips: -1, Earned: -int64(e.Amount)})
+	case events.ShipAddition:
+		if e.Amount == 0 {
+			return false
+		}
+		c.AddShip(realm(e.SubscriberEvent), e.ShipID, e.Resource, Counter{Ships: 1, Amount: int64(e.Amount)})
+	case events.ShipRemoval:
+		if e.Amount == 0 {
+			return false
+		}
+		change := Counter{Ships: -1, Amount: -int64(e.Amount), Earned: -int64(e.Earned)}
+		if e.Earned > 0 {
+			change.EarnedShips = -1
+		}
+		c.AddShip(realm(e.SubscriberEvent), e.ShipID, e.Resource, change)
+	default:
+		return false
+	}
+	return true
+}
+
+// Drift is a counter that does not match the value computed from the data of all subscribers
+type Drift struct {
+	Key     string
+	Counter Counter
+	Rebuilt Counter
+}
+
+// Diff returns the counters that differ from the rebuilt counters, sorted by key
+func (c Counters) Diff(rebuilt Counters) []Drift {
+	var drift []Drift
+	for key, counter := range c {
+		if expected := rebuilt[key]; counter != expected {
+			drift = append(drift, Drift{Key: key.String(), Counter: counter, Rebuilt: expected})
+		}
+	}
+	for key, expected := range rebuilt {
+		if _, ok := c[key]; !ok && !expected.IsZero() {
+			drift = append(drift, Drift{Key: key.String(), Rebuilt: expected})
+		}
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Key < drift[j].Key
+	})
+	return drift
+}
+
+// Corrections returns the changes that turn the counters into the rebuilt counters
+func (c Counters) Corrections(rebuilt Counters) Counters {
+	corrections := Counters{}
+	for key, counter := range c {
+		if change := rebuilt[key].sub(counter); !change.IsZero() {
+			corrections[key] = change
+		}
+	}
+	for key, expected := range rebuilt {
+		if _, ok := c[key]; !ok && !expected.IsZero() {
+			corrections[key] = expected
+		}
+	}
+	return corrections
+}
+
+type counterItem struct {
+	Key string
+	Counter
+}
+
+// Update adds the counters to the stored counters. It stops at the first error, the counters that were updated
+// before it stay updated, so calling it again with the same counters adds them twice.
+func (c Counters) Update(ctx context.Context) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	for key, change := range c {
+		if change.IsZero() {
+			continue
+		}
+
+		if _, err := svc.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String("whaling-stats"),
+			Key: map[string]*dynamodb.AttributeValue{
+				"Key": {
+					S: aws.String(key.String()),
+				},
+			},
+			UpdateExpression: aws.String("ADD #s :s, #es :es, #a :a, #e :e"),
+			ExpressionAttributeNames: map[string]*string{
+				"#s":  aws.String("Ships"),
+				"#es": aws.String("EarnedShips"),
+				"#a":  aws.String("Amount"),
+				"#e":  aws.String("Earned"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":s":  {N: aws.String(strconv.FormatInt(change.Ships, 10))},
+				":es": {N: aws.String(strconv.FormatInt(change.EarnedShips, 10))},
+				":a":  {N: aws.String(strconv.FormatInt(change.Amount, 10))},
+				":e":  {N: aws.String(strconv.FormatInt(change.Earned, 10))},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadCounters reads all stored counters. Items with an invalid key are skipped.
+func LoadCounters(ctx context.Context) (Counters, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	counters := Counters{}
+	var pageErr error
+	err := svc.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		TableName: aws.String("whaling-stats"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var items []counterItem
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			pageErr = err
+			return false
+		}
+
+		for _, item := range items {
+			key, err := ParseKey(item.Key)
+			if err != nil {
+				log.Printf("WARN: LoadCounters: skipping counter key=%s error=%v", item.Key, err)
+				continue
+			}
+			counters[key] = item.Counter
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counters, pageErr
+}
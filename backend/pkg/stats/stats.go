@@ -0,0 +1,342 @@
+// Package stats computes the global statistics of all subscribers, which are published for the frontend.
+package stats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"math"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// Version is the version of the statistics document. It is increased for changes that are not backwards compatible
+// and is part of the key the document is published with.
+const Version = 1
+
+// CompletionBuckets is the number of buckets of the completion distribution below 100%
+const CompletionBuckets = 10
+
+// UnknownRealm is used for subscribers whose realm is not known
+const UnknownRealm = "unknown"
+
+// Count is how many ships can earn a resource and how much of it was earned
+type Count struct {
+	Ships       int  `json:"ships"`
+	EarnedShips int  `json:"earnedShips"`
+	Amount      uint `json:"amount"`
+	Earned      uint `json:"earned"`
+}
+
+// Group counts ships per resource, as amounts of different resources can not be added up
+type Group map[wows.Resource]*Count
+
+// ShipCount counts a single ship over all players that own it
+type ShipCount struct {
+	Resource wows.Resource `json:"resource"`
+	Count
+}
+
+// Bucket is a range of the completion distribution. From is inclusive, To is exclusive except for the last bucket,
+// which only contains players that earned everything.
+type Bucket struct {
+	From    int `json:"from"`
+	To      int `json:"to"`
+	Players int `json:"players"`
+}
+
+// Participation counts the subscribers of a breakdown
+type Participation struct {
+	// Subscribers are all subscribers with data
+	Subscribers int `json:"subscribers"`
+	// Players are subscribers that have at least one ship that can earn a resource
+	Players int `json:"players"`
+	// Active are players that earned at least one resource
+	Active int `json:"active"`
+	// Completed are players that earned the resources of all their ships
+	Completed int `json:"completed"`
+	// Limited are subscribers whose last refresh could not read their private data
+	Limited int `json:"limited"`
+}
+
+// Breakdown are the statistics of a set of subscribers. Resources, tiers, nations and ships are filled from
+// the counters, see Statistics.SetCounters.
+type Breakdown struct {
+	Participation Participation        `json:"participation"`
+	Resources     Group                `json:"resources"`
+	Tiers         map[int]Group        `json:"tiers"`
+	Nations       map[string]Group     `json:"nations"`
+	Ships         map[int64]*ShipCount `json:"ships"`
+	// Completion is the distribution of the share of their resources players earned, see Completion
+	Completion []Bucket `json:"completion"`
+}
+
+func newBreakdown() *Breakdown {
+	b := &Breakdown{}
+	b.resetCounts()
+	for i := 0; i < CompletionBuckets; i++ {
+		b.Completion = append(b.Completion, Bucket{
+			From: i * 100 / CompletionBuckets,
+			To:   (i + 1) * 100 / CompletionBuckets,
+		})
+	}
+	b.Completion = append(b.Completion, Bucket{From: 100, To: 100})
+	return b
+}
+
+func (b *Breakdown) resetCounts() {
+	b.Resources = Group{}
+	b.Tiers = map[int]Group{}
+	b.Nations = map[string]Group{}
+	b.Ships = map[int64]*ShipCount{}
+}
+
+// setCount sets the count of a counter, counters of unknown dimensions or with invalid values are ignored
+func (b *Breakdown) setCount(key Key, count Count) {
+	switch key.Dimension {
+	case DimensionResource:
+		b.Resources[key.Resource] = &count
+	case DimensionTier:
+		tier, err := strconv.Atoi(key.Value)
+		if err != nil {
+			return
+		}
+		if _, ok := b.Tiers[tier]; !ok {
+			b.Tiers[tier] = Group{}
+		}
+		b.Tiers[tier][key.Resource] = &count
+	case DimensionNation:
+		if _, ok := b.Nations[key.Value]; !ok {
+			b.Nations[key.Value] = Group{}
+		}
+		b.Nations[key.Value][key.Resource] = &count
+	case DimensionShip:
+		shipID, err := strconv.ParseInt(key.Value, 10, 64)
+		if err != nil {
+			return
+		}
+		b.Ships[shipID] = &ShipCount{Resource: key.Resource, Count: count}
+	}
+}
+
+func (b *Breakdown) add(data *storage.SubscriberPublicData, completion float64, earned bool) {
+	b.Participation.Subscribers++
+	if data.Limited != nil {
+		b.Participation.Limited++
+	}
+
+	if math.IsNaN(completion) {
+		return
+	}
+	b.Participation.Players++
+	if earned {
+		b.Participation.Active++
+	}
+	if completion >= 1 {
+		b.Participation.Completed++
+		b.Completion[CompletionBuckets].Players++
+		return
+	}
+	b.Completion[int(completion*CompletionBuckets)].Players++
+}
+
+// Statistics is the document published for the frontend
+type Statistics struct {
+	Version     int       `json:"version"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	// Global are the statistics of all subscribers
+	Global *Breakdown `json:"global"`
+	// Realms are the statistics of the subscribers of every realm, subscribers without a realm are in UnknownRealm
+	Realms map[string]*Breakdown `json:"realms"`
+}
+
+// SetCounters replaces the resources, tiers, nations and ships of all breakdowns with the counters. Realms that
+// only have counters are added.
+func (s *Statistics) SetCounters(counters Counters) {
+	s.Global.resetCounts()
+	for _, breakdown := range s.Realms {
+		breakdown.resetCounts()
+	}
+
+	for key, counter := range counters {
+		breakdown := s.Global
+		if key.Scope != GlobalScope {
+			var ok bool
+			if breakdown, ok = s.Realms[key.Scope]; !ok {
+				breakdown = newBreakdown()
+				s.Realms[key.Scope] = breakdown
+			}
+		}
+		breakdown.setCount(key, counter.Count())
+	}
+}
+
+// Builder computes statistics from the data of one subscriber after another
+type Builder struct {
+	statistics *Statistics
+	counters   Counters
+}
+
+// NewBuilder creates a builder for statistics generated at the given time
+func NewBuilder(generatedAt time.Time) *Builder {
+	return &Builder{
+		statistics: &Statistics{
+			Version:     Version,
+			GeneratedAt: generatedAt.UTC(),
+			Global:      newBreakdown(),
+			Realms:      map[string]*Breakdown{},
+		},
+		counters: Counters{},
+	}
+}
+
+// Add counts the data of a subscriber
+func (b *Builder) Add(data *storage.SubscriberPublicData) {
+	realm := data.Realm
+	if realm == "" {
+		realm = UnknownRealm
+	}
+	breakdown, ok := b.statistics.Realms[realm]
+	if !ok {
+		breakdown = newBreakdown()
+		b.statistics.Realms[realm] = breakdown
+	}
+
+	completion, earned := Completion(data)
+	b.statistics.Global.add(data, completion, earned)
+	breakdown.add(data, completion, earned)
+	b.counters.AddData(data)
+}
+
+// Statistics returns the statistics of all subscribers added so far
+func (b *Builder) Statistics() *Statistics {
+	b.statistics.SetCounters(b.counters)
+	return b.statistics
+}
+
+// Counters returns the counters of all subscribers added so far
+func (b *Builder) Counters() Counters {
+	return b.counters
+}
+
+// Completion returns the share of their resources a subscriber earned, between 0 and 1, and whether they earned
+// anything. Every resource the subscriber can earn counts the same, regardless of its amount. It returns NaN
+// if there is nothing the subscriber can earn.
+func Completion(data *storage.SubscriberPublicData) (float64, bool) {
+	amounts := map[wows.Resource]uint{}
+	earned := map[wows.Resource]uint{}
+	for _, ship := range data.Ships {
+		amounts[ship.Resource.Type] += ship.Resource.Amount
+		earned[ship.Resource.Type] += ship.Resource.Earned
+	}
+
+	var sum float64
+	var resources int
+	var any bool
+	for r, amount := range amounts {
+		if amount == 0 {
+			continue
+		}
+		resources++
+		sum += math.Min(1, float64(earned[r])/float64(amount))
+		any = any || earned[r] > 0
+	}
+	if resources == 0 {
+		return math.NaN(), false
+	}
+	return sum / float64(resources), any
+}
+
+// LegacyTotal is a total of the old statistics.json, which identifies resources by their numeric value
+type LegacyTotal struct {
+	Type   uint
+	Amount uint
+	Earned uint
+}
+
+// Legacy returns the totals per resource in the format of the old statistics.json, for frontends that were
+// loaded before the versioned document was published
+func (s *Statistics) Legacy() []LegacyTotal {
+	totals := []LegacyTotal{}
+	for _, r := range wows.Resources {
+		count, ok := s.Global.Resources[r]
+		if !ok || count.Amount == 0 {
+			continue
+		}
+		totals = append(totals, LegacyTotal{Type: uint(r), Amount: count.Amount, Earned: count.Earned})
+	}
+	return totals
+}
+
+const reconciledKey = "stats/reconciled.json"
+
+// LoadReconciled reads the statistics saved by the last reconciliation, it returns nil if there are none
+func LoadReconciled(ctx context.Context) (*Statistics, error) {
+	location := storage.DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc := s3manager.NewDownloader(sess)
+
+	buf := &aws.WriteAtBuffer{}
+	if _, err := svc.DownloadWithContext(ctx, buf, &s3.GetObjectInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(reconciledKey),
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var statistics Statistics
+	if err := json.Unmarshal(buf.Bytes(), &statistics); err != nil {
+		return nil, err
+	}
+	if statistics.Version != Version {
+		log.Printf("WARN: LoadReconciled: ignoring statistics of another version version=%d", statistics.Version)
+		return nil, nil
+	}
+	return &statistics, nil
+}
+
+// SaveReconciled stores the statistics of a reconciliation, the participation and completion of the subscribers
+// are only computed by reconciliations
+func (s *Statistics) SaveReconciled(ctx context.Context) error {
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	location := storage.DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc := s3manager.NewUploader(sess)
+
+	_, err = svc.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(reconciledKey),
+		Body:   bytes.NewBuffer(body),
+	})
+	return err
+}
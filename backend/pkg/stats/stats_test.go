@@ -0,0 +1,24 @@
+package stats
+
+import (
+	"encoding/json"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"testing"
+	"time"
+)
+
+func TestLegacyKeepsNumericTypes(t *testing.T) {
+	statistics := NewBuilder(time.Unix(1600000000, 0)).Statistics()
+	statistics.Global.Resources[wows.Coal] = &Count{Ships: 2, Amount: 3000, Earned: 1500}
+	statistics.Global.Resources[wows.Steel] = &Count{Ships: 1}
+
+	data, err := json.Marshal(statistics.Legacy())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Resources without an amount are left out, like in the old statistics.json
+	if want := `[{"Type":1,"Amount":3000,"Earned":1500}]`; string(data) != want {
+		t.Errorf("Legacy() = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// HistoryKeepAll is how long every snapshot is kept, older snapshots are compacted to one per day
+var HistoryKeepAll = 48 * time.Hour
+
+// Snapshot is the progress of a subscriber after a refresh
+type Snapshot struct {
+	Timestamp int64
+	Revision  int64
+	// Resources only contains resources that can be earned
+	Resources   ResourceTotals
+	Ships       int
+	EarnedShips int
+	// Added and Earned are the ships that were added or earned their resource since the previous snapshot
+	Added  []int64 `json:",omitempty"`
+	Earned []int64 `json:",omitempty"`
+}
+
+// History is the timeline of a subscriber, stored next to the public data under history/
+type History struct {
+	AccountID string
+	Snapshots []Snapshot
+}
+
+// NewSnapshot creates a snapshot of the data
+func NewSnapshot(data *SubscriberPublicData, added, earned []int64) Snapshot {
+	snapshot := Snapshot{
+		Timestamp: data.LastUpdated,
+		Revision:  data.Revision,
+		Resources: ResourceTotals{},
+		Added:     added,
+		Earned:    earned,
+	}
+
+	for r, total := range data.Resources {
+		if total != nil && total.Amount > 0 {
+			t := *total
+			snapshot.Resources[r] = &t
+		}
+	}
+	for _, ship := range data.Ships {
+		snapshot.Ships++
+		if ship.Resource.Earned > 0 {
+			snapshot.EarnedShips++
+		}
+	}
+	return snapshot
+}
+
+// Append adds the snapshot and compacts the history
+func (h *History) Append(snapshot Snapshot, now time.Time) {
+	h.Snapshots = append(h.Snapshots, snapshot)
+	h.Compact(now)
+}
+
+// Compact keeps all snapshots of the last HistoryKeepAll. Of older snapshots, only the last one of every
+// day (UTC) is kept, with the ships added and earned that day merged into it.
+func (h *History) Compact(now time.Time) {
+	cutoff := now.Add(-HistoryKeepAll).UnixNano()
+	day := func(s Snapshot) string {
+		return time.Unix(0, s.Timestamp).UTC().Format("2006-01-02")
+	}
+
+	var compacted []Snapshot
+	for _, snapshot := range h.Snapshots {
+		last := len(compacted) - 1
+		if snapshot.Timestamp < cutoff && last >= 0 && compacted[last].Timestamp < cutoff && day(compacted[last]) == day(snapshot) {
+			snapshot.Added = mergeShips(compacted[last].Added, snapshot.Added)
+			snapshot.Earned = mergeShips(compacted[last].Earned, snapshot.Earned)
+			compacted[last] = snapshot
+			continue
+		}
+		compacted = append(compacted, snapshot)
+	}
+	h.Snapshots = compacted
+}
+
+// mergeShips returns the ships of both slices in a new slice, appending to a with spare capacity would write
+// into the backing array of a snapshot that is still referenced
+func mergeShips(a, b []int64) []int64 {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+	merged := make([]int64, 0, len(a)+len(b))
+	merged = append(merged, a...)
+	return append(merged, b...)
+}
+
+func historyKey(accountID string) string {
+	return fmt.Sprintf("history/%s.json", accountID)
+}
+
+// LoadHistory returns the history of a subscriber, which is empty if there is none yet
+func LoadHistory(ctx context.Context, accountID string) (*History, error) {
+	location := DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc := s3manager.NewDownloader(sess)
+
+	buf := &aws.WriteAtBuffer{}
+	if _, err := svc.DownloadWithContext(ctx, buf, &s3.GetObjectInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(historyKey(accountID)),
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return &History{AccountID: accountID}, nil
+		}
+		return nil, err
+	}
+
+	var history History
+	if err := json.Unmarshal(buf.Bytes(), &history); err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// Save writes the history, it is not public as it is only served through the API
+func (h *History) Save(ctx context.Context) error {
+	location := DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc := s3manager.NewUploader(sess)
+
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(historyKey(h.AccountID)),
+		Body:   bytes.NewBuffer(data),
+	})
+	return err
+}
+
+// AppendHistory records a snapshot of the data in the history of the subscriber.
+//
+// The history is not protected against concurrent writers like the public data is, two refreshes finishing at
+// the same time may lose one snapshot, which the next refresh makes up for.
+func AppendHistory(ctx context.Context, data *SubscriberPublicData, added, earned []int64) error {
+	history, err := LoadHistory(ctx, data.AccountID)
+	if err != nil {
+		return err
+	}
+
+	history.Append(NewSnapshot(data, added, earned), time.Now())
+	log.Printf("AppendHistory: accountId=%s snapshots=%d", data.AccountID, len(history.Snapshots))
+	return history.Save(ctx)
+}
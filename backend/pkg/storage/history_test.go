@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHistoryCompact(t *testing.T) {
+	now := time.Date(2020, 12, 20, 12, 0, 0, 0, time.UTC)
+	at := func(day, hour int) int64 {
+		return time.Date(2020, 12, day, hour, 0, 0, 0, time.UTC).UnixNano()
+	}
+
+	// The backing array of the first Added has spare capacity, merging must not write into it
+	added := make([]int64, 1, 4)
+	added[0] = 1
+
+	history := &History{Snapshots: []Snapshot{
+		{Timestamp: at(16, 8), Revision: 1, Added: added},
+		{Timestamp: at(16, 12), Revision: 2, Added: []int64{2}, Earned: []int64{1}},
+		{Timestamp: at(16, 20), Revision: 3, Earned: []int64{2}},
+		{Timestamp: at(17, 8), Revision: 4, Added: []int64{3}},
+		// The cutoff is on the 18th at 12:00, snapshots of the same day after it are all kept
+		{Timestamp: at(18, 8), Revision: 5, Added: []int64{4}},
+		{Timestamp: at(18, 14), Revision: 6, Added: []int64{5}},
+		{Timestamp: at(18, 20), Revision: 7, Earned: []int64{5}},
+		{Timestamp: at(20, 10), Revision: 8},
+		{Timestamp: at(20, 11), Revision: 9},
+	}}
+	history.Compact(now)
+
+	expected := []Snapshot{
+		{Timestamp: at(16, 20), Revision: 3, Added: []int64{1, 2}, Earned: []int64{1, 2}},
+		{Timestamp: at(17, 8), Revision: 4, Added: []int64{3}},
+		{Timestamp: at(18, 8), Revision: 5, Added: []int64{4}},
+		{Timestamp: at(18, 14), Revision: 6, Added: []int64{5}},
+		{Timestamp: at(18, 20), Revision: 7, Earned: []int64{5}},
+		{Timestamp: at(20, 10), Revision: 8},
+		{Timestamp: at(20, 11), Revision: 9},
+	}
+	if !reflect.DeepEqual(history.Snapshots, expected) {
+		t.Errorf("Snapshots = %+v, want %+v", history.Snapshots, expected)
+	}
+
+	if added[:cap(added)][1] != 0 {
+		t.Errorf("Compact wrote into the Added slice of a compacted snapshot: %v", added[:cap(added)])
+	}
+
+	// Compacting again does not change anything
+	history.Compact(now)
+	if !reflect.DeepEqual(history.Snapshots, expected) {
+		t.Errorf("Snapshots after compacting twice = %+v, want %+v", history.Snapshots, expected)
+	}
+}
@@ -0,0 +1,228 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// Location is where the public subscriber data is stored
+type Location struct {
+	Region string
+	Bucket string
+	Prefix string
+}
+
+// DefaultLocation is the location of the public subscriber data, the region and bucket can be changed with
+// SUBSCRIBERS_REGION and SUBSCRIBERS_BUCKET. Everything else stored in the bucket, like the history, the ship
+// catalogue and the reconciled statistics, uses the same region and bucket.
+func DefaultLocation() Location {
+	location := Location{
+		Region: "eu-central-1",
+		Bucket: "whaling-subscribers",
+		Prefix: "public/",
+	}
+	if region := os.Getenv("SUBSCRIBERS_REGION"); region != "" {
+		location.Region = region
+	}
+	if bucket := os.Getenv("SUBSCRIBERS_BUCKET"); bucket != "" {
+		location.Bucket = bucket
+	}
+	return location
+}
+
+// Object is a public subscriber data object, or the error that occurred while reading it
+type Object struct {
+	Key  string
+	Data *SubscriberPublicData
+	// Version is the schema version the object was stored with
+	Version int
+	Err     error
+}
+
+// SkippedObject is an object that could not be read
+type SkippedObject struct {
+	Key   string
+	Error string
+}
+
+// IterationReport is the result of Iterator.Each
+type IterationReport struct {
+	// Listed is the number of objects that were listed
+	Listed int
+	// Read is the number of objects that were passed to the callback
+	Read    int
+	Skipped []SkippedObject
+}
+
+// Iterator reads all public subscriber data objects without keeping them in memory
+type Iterator struct {
+	Location Location
+	// Concurrency is the number of objects that are downloaded at the same time
+	Concurrency int
+}
+
+// NewIterator creates an iterator over the objects in the DefaultLocation
+func NewIterator(concurrency int) *Iterator {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Iterator{
+		Location:    DefaultLocation(),
+		Concurrency: concurrency,
+	}
+}
+
+// Stream is a running iteration, see Iterator.Stream
+type Stream struct {
+	// Objects receives every listed object, it is closed once all objects were read or the iteration was cancelled
+	Objects <-chan Object
+
+	listed int
+	err    error
+}
+
+// Listed returns the number of listed objects, it may only be called after Objects was closed
+func (s *Stream) Listed() int {
+	return s.listed
+}
+
+// Err returns the error that stopped the listing of the objects or the error of the context, it may only be
+// called after Objects was closed
+func (s *Stream) Err() error {
+	return s.err
+}
+
+// Stream lists the objects and downloads them in the background. Objects that could not be downloaded or
+// decoded are sent with their error. The caller has to receive from Objects until it is closed, cancelling
+// the context stops the iteration early.
+func (it *Iterator) Stream(ctx context.Context) (*Stream, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(it.Location.Region),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s3client := s3.New(sess)
+	downloader := s3manager.NewDownloader(sess)
+
+	keys := make(chan string)
+	objects := make(chan Object, it.Concurrency)
+	stream := &Stream{Objects: objects}
+
+	var listErr error
+	go func() {
+		defer close(keys)
+
+		listErr = s3client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket: aws.String(it.Location.Bucket),
+			Prefix: aws.String(it.Location.Prefix),
+		}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			log.Printf("Iterator.Stream: got page bucket=%s objects=%d last=%v", it.Location.Bucket, len(page.Contents), lastPage)
+			for _, object := range page.Contents {
+				select {
+				case keys <- *object.Key:
+					stream.listed++
+				case <-ctx.Done():
+					return false
+				}
+			}
+			return true
+		})
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < it.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for key := range keys {
+				object := it.read(ctx, downloader, key)
+				select {
+				case objects <- object:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+
+		// keys is closed once the workers are done, the listing has finished at this point
+		stream.err = listErr
+		if stream.err == nil {
+			stream.err = ctx.Err()
+		}
+		close(objects)
+	}()
+
+	return stream, nil
+}
+
+func (it *Iterator) read(ctx context.Context, downloader *s3manager.Downloader, key string) Object {
+	object := Object{Key: key}
+
+	buf := &aws.WriteAtBuffer{}
+	if _, err := downloader.DownloadWithContext(ctx, buf, &s3.GetObjectInput{
+		Bucket: aws.String(it.Location.Bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		object.Err = fmt.Errorf("download: %v", err)
+		return object
+	}
+
+	object.Data, object.Version, object.Err = DecodePublicSubscriberData(buf.Bytes())
+	return object
+}
+
+// Each calls fn for every object that could be read, one object at a time. Objects that could not be read are
+// skipped and listed in the report. If fn returns an error, the iteration is stopped and the error is returned.
+// The report is also returned when the iteration was stopped early.
+func (it *Iterator) Each(ctx context.Context, fn func(key string, data *SubscriberPublicData) error) (*IterationReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := it.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &IterationReport{}
+	var fnErr error
+	for object := range stream.Objects {
+		// The remaining objects are drained after an error, so that the workers can stop
+		if fnErr != nil {
+			continue
+		}
+
+		if object.Err != nil {
+			log.Printf("WARN: Iterator.Each: skipping object key=%s error=%v", object.Key, object.Err)
+			report.Skipped = append(report.Skipped, SkippedObject{Key: object.Key, Error: object.Err.Error()})
+			continue
+		}
+
+		if fnErr = fn(object.Key, object.Data); fnErr != nil {
+			cancel()
+			continue
+		}
+		report.Read++
+	}
+	report.Listed = stream.Listed()
+
+	log.Printf("Iterator.Each: done listed=%d read=%d skipped=%d", report.Listed, report.Read, len(report.Skipped))
+	if fnErr != nil {
+		return report, fnErr
+	}
+	return report, stream.Err()
+}
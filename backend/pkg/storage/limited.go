@@ -0,0 +1,36 @@
+package storage
+
+const (
+	// LimitedHiddenProfile is used when the Wargaming API returned no data because the profile is hidden
+	LimitedHiddenProfile = "hidden_profile"
+	// LimitedMissingPrivate is used when the port or some ships were returned without their private data,
+	// usually because the access token does not grant access to it anymore
+	LimitedMissingPrivate = "missing_private"
+)
+
+// Limited tells why the last refresh only got part of the data of a subscriber. The ships keep the garage
+// state of the last refresh that had the private data, so they are not removed from the port.
+type Limited struct {
+	// Reason is LimitedHiddenProfile or LimitedMissingPrivate
+	Reason string
+	// Since is when the data became limited, in nanoseconds
+	Since int64
+}
+
+// SetLimited marks the data as limited for the given reason, an empty reason clears it. Since is kept
+// while the data stays limited.
+func (s *SubscriberPublicData) SetLimited(reason string, now int64) {
+	if reason == "" {
+		s.Limited = nil
+		return
+	}
+
+	if s.Limited != nil {
+		s.Limited.Reason = reason
+		return
+	}
+	s.Limited = &Limited{
+		Reason: reason,
+		Since:  now,
+	}
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ManualPending is a manual credit that was not checked against the statistics yet
+	ManualPending = "pending"
+	// ManualConfirmed is a manual credit for which a refresh found a battle after the ship was marked
+	ManualConfirmed = "confirmed"
+	// ManualFlagged is a manual credit for which no battle was found within ManualConfirmWithin
+	ManualFlagged = "flagged"
+)
+
+var (
+	// ManualConfirmWithin is how long a refresh looks for a battle that confirms a manual credit before flagging it
+	ManualConfirmWithin = 24 * time.Hour
+
+	ErrNotMarked      = errors.New("ship was not marked as played")
+	ErrAlreadyEarned  = errors.New("ship already earned its resource")
+	ErrEarnedInBattle = errors.New("ship earned its resource in a battle")
+)
+
+// ManualCredit records that the subscriber marked a ship as played. It is kept apart from Credit, so that the
+// statistics of the ship are not touched and a refresh can still detect the battle.
+type ManualCredit struct {
+	// MarkedAt is when the ship was marked, in nanoseconds
+	MarkedAt int64
+	// LastBattleTime is the time of the last known battle of the ship when it was marked, in seconds
+	LastBattleTime int64
+	// Status is ManualPending, ManualConfirmed or ManualFlagged
+	Status string
+	// CheckedAt is when a refresh confirmed or flagged the credit, in nanoseconds
+	CheckedAt int64 `json:",omitempty"`
+}
+
+// MarkAsPlayed credits the resource of the ship because the subscriber said they played it
+func (s *StoredShip) MarkAsPlayed(now time.Time) error {
+	if s.Resource.Earned > 0 {
+		return ErrAlreadyEarned
+	}
+
+	var lastBattleTime int64
+	if s.ShipStatistics != nil {
+		lastBattleTime = int64(s.LastBattleTime)
+	}
+
+	s.Resource.Earned = s.Resource.Amount
+	s.ManualCredit = &ManualCredit{
+		MarkedAt:       now.UnixNano(),
+		LastBattleTime: lastBattleTime,
+		Status:         ManualPending,
+	}
+	return nil
+}
+
+// Unmark takes back a manual credit. Credits that were confirmed by a battle can not be taken back.
+func (s *StoredShip) Unmark() error {
+	if s.Resource.Earned == 0 {
+		return ErrNotMarked
+	}
+	// Credits with BattleTypeManual were written by an older version of MarkAsPlayed
+	if s.Credit != nil && s.Credit.BattleType != BattleTypeManual {
+		return ErrEarnedInBattle
+	}
+	if s.ManualCredit == nil && s.Credit == nil {
+		return ErrNotMarked
+	}
+
+	// The time of the last battle is left alone: MarkAsPlayed does not change it, so it may already be newer from a
+	// refresh, and older versions that overwrote it when marking did not keep the original one
+	s.Resource.Earned = 0
+	s.ManualCredit = nil
+	s.Credit = nil
+	return nil
+}
+
+// CheckManualCredit compares a pending manual credit with the new statistics of the ship. It is confirmed when
+// there was a battle after the last one known when marking, and flagged when there was none within
+// ManualConfirmWithin. It returns whether the status changed.
+func (s *StoredShip) CheckManualCredit(newLastBattleTime, eventStart int64, battleType string, now time.Time) bool {
+	if s.ManualCredit == nil || s.ManualCredit.Status != ManualPending || s.Credit != nil {
+		return false
+	}
+
+	if newLastBattleTime > s.ManualCredit.LastBattleTime && newLastBattleTime > eventStart {
+		s.CreditWith(battleType, now.UnixNano(), newLastBattleTime)
+		s.ManualCredit.Status = ManualConfirmed
+		s.ManualCredit.CheckedAt = now.UnixNano()
+		return true
+	}
+
+	if now.Sub(time.Unix(0, s.ManualCredit.MarkedAt)) > ManualConfirmWithin {
+		s.ManualCredit.Status = ManualFlagged
+		s.ManualCredit.CheckedAt = now.UnixNano()
+		return true
+	}
+	return false
+}
+
+// UnknownShipsError lists ships the subscriber does not have
+type UnknownShipsError struct {
+	ShipIDs []int64
+}
+
+func (e *UnknownShipsError) Error() string {
+	return fmt.Sprintf("unknown ships for player: %v", e.ShipIDs)
+}
+
+// MarkShipsAsPlayed marks all given ships as played. Ships that already earned their resource are skipped and
+// returned separately. If any of the ships is unknown, nothing is changed and an UnknownShipsError is returned.
+func (s *SubscriberPublicData) MarkShipsAsPlayed(shipIDs []int64, now time.Time) ([]*StoredShip, []int64, error) {
+	var known, unknown []int64
+	seen := map[int64]bool{}
+	for _, shipID := range shipIDs {
+		if seen[shipID] {
+			continue
+		}
+		seen[shipID] = true
+
+		if _, ok := s.Ships[shipID]; !ok {
+			unknown = append(unknown, shipID)
+			continue
+		}
+		known = append(known, shipID)
+	}
+	if len(unknown) > 0 {
+		return nil, nil, &UnknownShipsError{ShipIDs: unknown}
+	}
+
+	var marked []*StoredShip
+	var alreadyEarned []int64
+	for _, shipID := range known {
+		ship := s.Ships[shipID]
+		if err := ship.MarkAsPlayed(now); err != nil {
+			alreadyEarned = append(alreadyEarned, shipID)
+			continue
+		}
+		marked = append(marked, ship)
+	}
+
+	if len(marked) > 0 {
+		s.UpdateEarnedResources()
+	}
+	return marked, alreadyEarned, nil
+}
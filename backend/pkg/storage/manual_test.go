@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/api"
+	"testing"
+	"time"
+)
+
+func TestUnmark(t *testing.T) {
+	markedAt := time.Date(2020, 12, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ship func() *StoredShip
+		err  error
+		// lastBattleTime is the time of the last battle the ship must have after unmarking
+		lastBattleTime int
+	}{
+		{
+			name: "marked",
+			ship: func() *StoredShip {
+				ship := newShip(100)
+				ship.MarkAsPlayed(markedAt)
+				return ship
+			},
+			lastBattleTime: 100,
+		},
+		{
+			name: "marked, refreshed after a battle before the event started",
+			ship: func() *StoredShip {
+				ship := newShip(100)
+				ship.MarkAsPlayed(markedAt)
+				ship.LastBattleTime = 200
+				return ship
+			},
+			lastBattleTime: 200,
+		},
+		{
+			name: "marked by an older version",
+			ship: func() *StoredShip {
+				ship := newShip(int(markedAt.Unix()))
+				ship.CreditWith(BattleTypeManual, markedAt.UnixNano(), markedAt.Unix())
+				return ship
+			},
+			lastBattleTime: int(markedAt.Unix()),
+		},
+		{
+			name: "earned in a battle",
+			ship: func() *StoredShip {
+				ship := newShip(100)
+				ship.CreditWith("pvp", markedAt.UnixNano(), 100)
+				return ship
+			},
+			err:            ErrEarnedInBattle,
+			lastBattleTime: 100,
+		},
+		{
+			name: "earned without a record",
+			ship: func() *StoredShip {
+				ship := newShip(100)
+				ship.Resource.Earned = ship.Resource.Amount
+				return ship
+			},
+			err:            ErrNotMarked,
+			lastBattleTime: 100,
+		},
+		{
+			name:           "not earned",
+			ship:           func() *StoredShip { return newShip(100) },
+			err:            ErrNotMarked,
+			lastBattleTime: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ship := tt.ship()
+			if err := ship.Unmark(); err != tt.err {
+				t.Fatalf("Unmark() = %v, want %v", err, tt.err)
+			}
+			if ship.LastBattleTime != tt.lastBattleTime {
+				t.Errorf("LastBattleTime = %d, want %d", ship.LastBattleTime, tt.lastBattleTime)
+			}
+			if tt.err != nil {
+				return
+			}
+			if ship.Resource.Earned != 0 || ship.ManualCredit != nil || ship.Credit != nil {
+				t.Errorf("ship still earned its resource: %+v", ship)
+			}
+		})
+	}
+}
+
+func newShip(lastBattleTime int) *StoredShip {
+	return &StoredShip{
+		ShipStatistics: &api.ShipStatistics{ShipID: 4181604048, LastBattleTime: lastBattleTime},
+		Resource:       EarnableResource{Type: wows.Coal, Amount: 100},
+	}
+}
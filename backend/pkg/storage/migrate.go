@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/gammazero/workerpool"
+)
+
+// MigrationFailure is an object that could not be migrated
+type MigrationFailure struct {
+	Key   string
+	Error string
+}
+
+// MigrationReport is the result of MigratePublicSubscriberData
+type MigrationReport struct {
+	Total int
+	// Current is the number of objects that already had the current schema version
+	Current int
+	// Migrated is the number of objects that were rewritten, or would be on a dry run
+	Migrated int
+	// FromVersion counts the migrated objects by the version they had before
+	FromVersion map[int]int
+	Failed      []MigrationFailure
+}
+
+// MigratePublicSubscriberData rewrites every object under public/ that has an older schema version. Objects of
+// subscribers are written through UpdatePublicSubscriberData, so that running refreshes are not overwritten.
+// A failing object does not stop the migration, it is listed in the report instead.
+func MigratePublicSubscriberData(ctx context.Context, dryRun bool, concurrency int) (*MigrationReport, error) {
+	location := DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s3client := s3.New(sess)
+	downloader := s3manager.NewDownloader(sess)
+
+	report := &MigrationReport{FromVersion: map[int]int{}}
+	var mu sync.Mutex
+	fail := func(key string, err error) {
+		log.Printf("ERROR: MigratePublicSubscriberData: key=%s error=%v", key, err)
+		mu.Lock()
+		report.Failed = append(report.Failed, MigrationFailure{Key: key, Error: err.Error()})
+		mu.Unlock()
+	}
+
+	workers := workerpool.New(concurrency)
+	err = s3client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(location.Bucket),
+		Prefix: aws.String(location.Prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := *object.Key
+
+			mu.Lock()
+			report.Total++
+			mu.Unlock()
+
+			workers.Submit(func() {
+				buf := &aws.WriteAtBuffer{}
+				if _, err := downloader.DownloadWithContext(ctx, buf, &s3.GetObjectInput{
+					Bucket: aws.String(location.Bucket),
+					Key:    aws.String(key),
+				}); err != nil {
+					fail(key, fmt.Errorf("download: %v", err))
+					return
+				}
+
+				data, version, err := DecodePublicSubscriberData(buf.Bytes())
+				if err != nil {
+					fail(key, err)
+					return
+				}
+
+				if version == CurrentSchemaVersion {
+					mu.Lock()
+					report.Current++
+					mu.Unlock()
+					return
+				}
+
+				if !dryRun {
+					if err := rewritePublicSubscriberData(ctx, location, key, data); err != nil {
+						fail(key, err)
+						return
+					}
+				}
+				log.Printf("MigratePublicSubscriberData: key=%s fromVersion=%d dryRun=%t", key, version, dryRun)
+
+				mu.Lock()
+				report.Migrated++
+				report.FromVersion[version]++
+				mu.Unlock()
+			})
+		}
+		return ctx.Err() == nil
+	})
+	workers.StopWait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	return report, err
+}
+
+// rewritePublicSubscriberData saves migrated data back to its key
+func rewritePublicSubscriberData(ctx context.Context, location Location, key string, data *SubscriberPublicData) error {
+	dataURL := "https://whaling.in.fkn.space/" + strings.TrimPrefix(key, location.Prefix)
+
+	subscriber, err := GetSubscriber(data.AccountID)
+	if err != nil {
+		return fmt.Errorf("get subscriber: %v", err)
+	}
+
+	// Objects that are no longer used by a subscriber have no writers and no revision to claim
+	if subscriber.DataURL != dataURL {
+		return data.SaveWithContext(ctx, dataURL, false)
+	}
+
+	// The data is loaded and upgraded again, nothing else needs to change
+	_, err = UpdatePublicSubscriberData(ctx, data.AccountID, dataURL, func(*SubscriberPublicData, bool) error {
+		return nil
+	})
+	return err
+}
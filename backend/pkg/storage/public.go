@@ -2,18 +2,21 @@ package storage
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io/ioutil"
 	"log"
 	"net/url"
 	"path"
 	"rukenshia/frenchwhaling/pkg/wows"
 	"rukenshia/frenchwhaling/pkg/wows/api"
-	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
-	"github.com/gammazero/workerpool"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -22,118 +25,250 @@ type EarnableResource struct {
 	Type   wows.Resource
 	Amount uint
 	Earned uint
+	// ByBattleType splits Earned by the battle type of the credits, it is only set for totals
+	ByBattleType map[string]uint `json:",omitempty"`
+}
+
+const (
+	// BattleTypeManual is used for ships that were marked as played by the subscriber
+	BattleTypeManual = "manual"
+	// BattleTypeUnknown is used for credits made before the battle type was recorded, or when no new win was found
+	BattleTypeUnknown = "unknown"
+)
+
+// Credit records how a ship earned its resource
+type Credit struct {
+	// BattleType is the mode of the battle, e.g. pvp, pve, oper_solo, oper_div or rank_solo
+	BattleType string
+	// CreditedAt is when the refresh that credited the resource ran, in nanoseconds
+	CreditedAt int64
+	// LastBattleTime is the time of the battle that earned the resource in seconds
+	LastBattleTime int64
 }
 
 type StoredShip struct {
 	*api.ShipStatistics
 	Resource EarnableResource
+	// Credit is set once the resource was earned in a battle that was detected by a refresh
+	Credit *Credit `json:",omitempty"`
+	// ManualCredit is set when the subscriber marked the ship as played, see MarkAsPlayed
+	ManualCredit *ManualCredit `json:",omitempty"`
+}
+
+// CreditWith marks the resource of the ship as earned
+func (s *StoredShip) CreditWith(battleType string, creditedAt, lastBattleTime int64) {
+	if battleType == "" {
+		battleType = BattleTypeUnknown
+	}
+	s.Resource.Earned = s.Resource.Amount
+	s.Credit = &Credit{
+		BattleType:     battleType,
+		CreditedAt:     creditedAt,
+		LastBattleTime: lastBattleTime,
+	}
+}
+
+// BattleType returns the battle type the resource was earned in, BattleTypeUnknown for old credits
+func (s *StoredShip) BattleType() string {
+	if s.Credit != nil {
+		return s.Credit.BattleType
+	}
+	if s.ManualCredit != nil {
+		return BattleTypeManual
+	}
+	return BattleTypeUnknown
+}
+
+// ResourceTotals are the amounts of all resources of a subscriber, keyed by resource
+//
+// In JSON it is an object keyed by resource ID. Old data stored it as a list indexed by the numeric
+// value of the resource, which is still read.
+type ResourceTotals map[wows.Resource]*EarnableResource
+
+// Get returns the total of a resource, which is empty when the resource is not known yet
+func (t ResourceTotals) Get(r wows.Resource) EarnableResource {
+	if total, ok := t[r]; ok && total != nil {
+		return *total
+	}
+	return EarnableResource{Type: r}
+}
+
+// UnmarshalJSON reads the totals as an object or as the old list
+func (t *ResourceTotals) UnmarshalJSON(data []byte) error {
+	var list []*EarnableResource
+	if err := json.Unmarshal(data, &list); err == nil {
+		totals := ResourceTotals{}
+		for _, total := range list {
+			if total != nil {
+				totals[total.Type] = total
+			}
+		}
+		*t = totals
+		return nil
+	}
+
+	var totals map[wows.Resource]*EarnableResource
+	if err := json.Unmarshal(data, &totals); err != nil {
+		return err
+	}
+	*t = totals
+	return nil
 }
 
 type SubscriberPublicData struct {
-	AccountID   string
+	// SchemaVersion is the version of the stored document, older documents are upgraded on load, see Migrations
+	SchemaVersion int
+
+	AccountID string
+	// Realm is set by every refresh, documents from before it was stored get it from the account ID
+	Realm       string
 	LastUpdated int64
+	// Revision is increased with every save, see UpdatePublicSubscriberData
+	Revision int64
 
-	Resources []*EarnableResource
+	Resources ResourceTotals
 
 	Ships map[int64]*StoredShip
+
+	// Limited is set when the last refresh could not get the private data of the account, see SetLimited
+	Limited *Limited `json:",omitempty"`
+	// FirstSnapshot is set once the first complete data was saved to private/ as the baseline of the analytics
+	FirstSnapshot bool `json:",omitempty"`
 }
 
-func GetAllPublicSubscriberData() ([]SubscriberPublicData, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config: aws.Config{
-			Region: aws.String("eu-central-1"),
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-	s3client := s3.New(sess)
-
-	workers := workerpool.New(16)
-	objects := sync.Map{}
-
-	s3client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
-		Bucket: aws.String("whaling-subscribers"),
-		Prefix: aws.String("public/"),
-	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
-		log.Printf("GetAllPublicSubscriberData: got page page=%s objects=%d last=%v", *page.Name, len(page.Contents), lastPage)
-		for _, object := range page.Contents {
-			key := object.Key
-			workers.Submit(func() {
-				log.Printf("GetAllPublicSubscriberData: downloading key=%s", *key)
-				svc := s3manager.NewDownloader(sess)
-				buf := &aws.WriteAtBuffer{}
-				_, err := svc.Download(buf, &s3.GetObjectInput{
-					Bucket: aws.String("whaling-subscribers"),
-					Key:    key,
-				})
-				if err != nil {
-					log.Fatal(err)
-					return
-				}
-
-				var data SubscriberPublicData
-				if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
-					log.Fatal(err)
-					return
-				}
-
-				objects.Store(*key, data)
-			})
+// NewSubscriberPublicData creates the data for a subscriber that was never refreshed before
+func NewSubscriberPublicData(accountID string) *SubscriberPublicData {
+	data := &SubscriberPublicData{
+		SchemaVersion: CurrentSchemaVersion,
+		AccountID:     accountID,
+		Realm:         realmFromAccountID(accountID),
+		Resources:     ResourceTotals{},
+		Ships:         map[int64]*StoredShip{},
+		LastUpdated:   time.Now().UnixNano(),
+	}
+	for _, r := range wows.Resources {
+		data.Resources[r] = &EarnableResource{Type: r}
+	}
+	return data
+}
+
+// UpdateEarnedResources sums up the amount and earned resources of all ships
+func (s *SubscriberPublicData) UpdateEarnedResources() {
+	if s.Resources == nil {
+		s.Resources = ResourceTotals{}
+	}
+	for _, r := range wows.Resources {
+		s.Resources[r] = &EarnableResource{Type: r}
+	}
+
+	for _, ship := range s.Ships {
+		total, ok := s.Resources[ship.Resource.Type]
+		if !ok {
+			total = &EarnableResource{Type: ship.Resource.Type}
+			s.Resources[ship.Resource.Type] = total
 		}
-		return true
-	})
-	workers.StopWait()
+		total.Amount += ship.Resource.Amount
+		total.Earned += ship.Resource.Earned
 
-	var objectList []SubscriberPublicData
-	objects.Range(func(key interface{}, object interface{}) bool {
-		objectList = append(objectList, object.(SubscriberPublicData))
-		return true
-	})
-	return objectList, nil
+		if ship.Resource.Earned > 0 {
+			if total.ByBattleType == nil {
+				total.ByBattleType = map[string]uint{}
+			}
+			total.ByBattleType[ship.BattleType()] += ship.Resource.Earned
+		}
+	}
 }
 
 func LoadPublicSubscriberData(dataURL string) (*SubscriberPublicData, error) {
+	return LoadPublicSubscriberDataWithContext(context.Background(), dataURL)
+}
+
+// LoadPublicSubscriberDataWithContext is the same as LoadPublicSubscriberData with the addition of
+// a context that can be used to cancel the download
+func LoadPublicSubscriberDataWithContext(ctx context.Context, dataURL string) (*SubscriberPublicData, error) {
+	data, _, err := loadPublicSubscriberData(ctx, dataURL)
+	return data, err
+}
+
+// loadPublicSubscriberData also returns the ETag of the object, which fences the next save, see saveIfUnchanged
+func loadPublicSubscriberData(ctx context.Context, dataURL string) (*SubscriberPublicData, string, error) {
+	location := DefaultLocation()
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config: aws.Config{
-			Region: aws.String("eu-central-1"),
+			Region: aws.String(location.Region),
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	svc := s3manager.NewDownloader(sess)
+	svc := s3.New(sess)
 
 	parsedURL, err := url.Parse(dataURL)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	buf := &aws.WriteAtBuffer{}
-
-	log.Printf("LoadPublicSubscriberData: key=%s", path.Join("public", parsedURL.Path))
+	log.Printf("LoadPublicSubscriberData: bucket=%s key=%s", location.Bucket, path.Join(location.Prefix, parsedURL.Path))
 
-	n, err := svc.Download(buf, &s3.GetObjectInput{
-		Bucket: aws.String("whaling-subscribers"),
-		Key:    aws.String(path.Join("public", parsedURL.Path)),
+	out, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(path.Join(location.Prefix, parsedURL.Path)),
 	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	defer out.Body.Close()
+
+	raw, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, "", err
 	}
-	log.Printf("LoadPublicSubscriberData: downloaded %d bytes", n)
+	log.Printf("LoadPublicSubscriberData: downloaded %d bytes", len(raw))
 
-	var data SubscriberPublicData
-	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
-		return nil, err
+	data, version, err := DecodePublicSubscriberData(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	if version != CurrentSchemaVersion {
+		log.Printf("LoadPublicSubscriberData: upgraded from schemaVersion=%d to %d", version, CurrentSchemaVersion)
 	}
 
-	return &data, nil
+	return data, aws.StringValue(out.ETag), nil
 }
 
 func (s *SubscriberPublicData) Save(dataURL string, isNew bool) error {
+	return s.SaveWithContext(context.Background(), dataURL, isNew)
+}
+
+// SaveWithContext is the same as Save with the addition of a context that can be used to cancel the upload
+func (s *SubscriberPublicData) SaveWithContext(ctx context.Context, dataURL string, isNew bool) error {
+	return s.save(ctx, dataURL, isNew, nil)
+}
+
+// saveIfUnchanged saves the data only if the object still has the ETag it was loaded with, or still does not exist
+// for new data. It returns ErrConflict if another writer saved the object in the meantime.
+func (s *SubscriberPublicData) saveIfUnchanged(ctx context.Context, dataURL string, isNew bool, etag string) error {
+	condition := func(r *request.Request) {
+		if isNew {
+			r.HTTPRequest.Header.Set("If-None-Match", "*")
+		} else {
+			r.HTTPRequest.Header.Set("If-Match", etag)
+		}
+	}
+
+	err := s.save(ctx, dataURL, isNew, condition)
+	if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "PreconditionFailed" || aerr.Code() == "ConditionalRequestConflict") {
+		return ErrConflict
+	}
+	return err
+}
+
+// save writes the data, condition is added to the upload of the public data when it is set
+func (s *SubscriberPublicData) save(ctx context.Context, dataURL string, isNew bool, condition request.Option) error {
+	location := DefaultLocation()
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config: aws.Config{
-			Region: aws.String("eu-central-1"),
+			Region: aws.String(location.Region),
 		},
 	})
 	if err != nil {
@@ -146,31 +281,66 @@ func (s *SubscriberPublicData) Save(dataURL string, isNew bool) error {
 		return err
 	}
 
-	data, err := json.Marshal(s)
-	if err != nil {
-		return err
+	// The first snapshot of a hidden profile would be empty, it is taken by the first save with complete data instead
+	privateKey := path.Join("private", parsedURL.Path)
+	firstSnapshot := false
+	if s.Limited != nil {
+		if !s.FirstSnapshot {
+			log.Printf("SubscriberPublicData.Save: data is limited, not saving first snapshot reason=%s", s.Limited.Reason)
+		}
+	} else if !s.FirstSnapshot {
+		// Data saved before FirstSnapshot was recorded may have a snapshot already
+		firstSnapshot = isNew
+		if !isNew {
+			if firstSnapshot, err = objectMissing(ctx, s3.New(sess), location.Bucket, privateKey); err != nil {
+				return err
+			}
+		}
+		s.FirstSnapshot = true
 	}
 
-	log.Printf("SubscriberPublicData.Save: key=%s", path.Join("public", parsedURL.Path))
-	_, err = svc.Upload(&s3manager.UploadInput{
-		Bucket: aws.String("whaling-subscribers"),
-		Key:    aws.String(path.Join("public", parsedURL.Path)),
-		Body:   bytes.NewBuffer(data),
-		ACL:    aws.String("public-read"),
-	})
+	// Loaded data was upgraded already, so it is always written with the current version
+	s.SchemaVersion = CurrentSchemaVersion
+	data, err := json.Marshal(s)
 	if err != nil {
 		return err
 	}
 
-	if isNew {
-		log.Printf("SubscriberPublicData.Save: is new subscriber, saving first snapshot")
-
-		_, err = svc.Upload(&s3manager.UploadInput{
-			Bucket: aws.String("whaling-subscribers"),
-			Key:    aws.String(path.Join("private", parsedURL.Path)),
+	if firstSnapshot {
+		log.Printf("SubscriberPublicData.Save: saving first snapshot key=%s", privateKey)
+		if _, err := svc.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket: aws.String(location.Bucket),
+			Key:    aws.String(privateKey),
 			Body:   bytes.NewBuffer(data),
-		})
+		}); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	var options []request.Option
+	if condition != nil {
+		options = append(options, condition)
+	}
+
+	// A single PutObject, as the condition can not be applied to multipart uploads
+	log.Printf("SubscriberPublicData.Save: bucket=%s key=%s", location.Bucket, path.Join(location.Prefix, parsedURL.Path))
+	_, err = s3.New(sess).PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(path.Join(location.Prefix, parsedURL.Path)),
+		Body:   bytes.NewReader(data),
+		ACL:    aws.String("public-read"),
+	}, options...)
+	return err
+}
+
+// objectMissing returns whether the object does not exist
+func objectMissing(ctx context.Context, svc *s3.S3, bucket, key string) (bool, error) {
+	_, err := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
+		return true, nil
+	}
+	return false, err
 }
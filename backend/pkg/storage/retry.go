@@ -0,0 +1,239 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+var (
+	// MaxRefreshAttempts is the number of times a refresh is tried before it is dead-lettered
+	MaxRefreshAttempts = 5
+	// RetryBaseDelay is the delay before the first retry, every further retry waits four times as long
+	RetryBaseDelay = time.Minute
+	// RetryMaxDelay caps the delay between two retries
+	RetryMaxDelay = 2 * time.Hour
+)
+
+// FailedRefresh is a refresh that failed and is either waiting to be retried or was dead-lettered
+//
+// The access token is not stored with it, retries always use the current token of the subscriber.
+type FailedRefresh struct {
+	AccountID string
+	Realm     string
+	// Attempt is the number of attempts that have failed so far
+	Attempt       int
+	Reason        string
+	Error         string
+	FirstFailedAt int64
+	LastFailedAt  int64
+	// NotBefore is when the next attempt should be made
+	NotBefore int64
+	// DeadLetteredAt is set once the refresh was given up
+	DeadLetteredAt int64 `json:",omitempty"`
+}
+
+// RetryDelay returns how long to wait before the given attempt
+func RetryDelay(attempt int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 4
+		if delay >= RetryMaxDelay {
+			return RetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// ScheduleRetry stores a failed refresh so that it is retried with exponential backoff. Once the
+// refresh failed MaxRefreshAttempts times, it is moved to the dead-letter table instead.
+// The attempts continue from the retry that is already stored for the account, so that a failed regular
+// refresh does not reset them. The returned bool tells whether the refresh was dead-lettered.
+func ScheduleRetry(ev RefreshEvent, reason string, cause error) (bool, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	stored, err := getFailedRefresh(svc, "whaling-refresh-retries", ev.AccountID)
+	if err != nil {
+		return false, err
+	}
+	if stored != nil {
+		if stored.Attempt > ev.Attempt {
+			ev.Attempt = stored.Attempt
+		}
+		if ev.FirstFailedAt == 0 || (stored.FirstFailedAt != 0 && stored.FirstFailedAt < ev.FirstFailedAt) {
+			ev.FirstFailedAt = stored.FirstFailedAt
+		}
+	}
+
+	now := time.Now()
+	attempt := ev.Attempt + 1
+
+	failed := FailedRefresh{
+		AccountID:     ev.AccountID,
+		Realm:         ev.Realm,
+		Attempt:       attempt,
+		Reason:        reason,
+		Error:         cause.Error(),
+		FirstFailedAt: now.UnixNano(),
+		LastFailedAt:  now.UnixNano(),
+		NotBefore:     now.Add(RetryDelay(attempt)).UnixNano(),
+	}
+	if ev.FirstFailedAt != 0 {
+		failed.FirstFailedAt = ev.FirstFailedAt
+	}
+
+	table := "whaling-refresh-retries"
+	if attempt >= MaxRefreshAttempts {
+		table = "whaling-refresh-deadletters"
+		failed.DeadLetteredAt = now.UnixNano()
+		log.Printf("ScheduleRetry: giving up, dead-lettering accountId=%s attempt=%d reason=%s", ev.AccountID, attempt, reason)
+	} else {
+		log.Printf("ScheduleRetry: accountId=%s attempt=%d reason=%s notBefore=%d", ev.AccountID, attempt, reason, failed.NotBefore)
+	}
+
+	av, err := dynamodbattribute.MarshalMap(failed)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item:      av,
+	}); err != nil {
+		return false, err
+	}
+
+	if failed.DeadLetteredAt != 0 && stored != nil {
+		if err := deleteFailedRefresh("whaling-refresh-retries", ev.AccountID); err != nil {
+			log.Printf("ERROR: ScheduleRetry: could not delete retry of dead-lettered refresh accountId=%s error=%v", ev.AccountID, err)
+		}
+	}
+	return failed.DeadLetteredAt != 0, nil
+}
+
+// FindDueRetries returns all failed refreshes that should be retried now
+func FindDueRetries(now int64) ([]*FailedRefresh, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	var retries []*FailedRefresh
+	var pageErr error
+	err := svc.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String("whaling-refresh-retries"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":n": {
+				N: aws.String(fmt.Sprintf("%d", now)),
+			},
+		},
+		FilterExpression: aws.String("NotBefore <= :n"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var items []*FailedRefresh
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			pageErr = err
+			return false
+		}
+		retries = append(retries, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retries, pageErr
+}
+
+// DeleteRetry removes a failed refresh from the retry table, after it was sent again or the account was
+// refreshed successfully
+func DeleteRetry(accountID string) error {
+	return deleteFailedRefresh("whaling-refresh-retries", accountID)
+}
+
+// ListDeadLetters returns all refreshes that were given up
+func ListDeadLetters() ([]*FailedRefresh, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	var deadLetters []*FailedRefresh
+	var pageErr error
+	err := svc.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String("whaling-refresh-deadletters"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var items []*FailedRefresh
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			pageErr = err
+			return false
+		}
+		deadLetters = append(deadLetters, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deadLetters, pageErr
+}
+
+// GetDeadLetter returns the dead-lettered refresh of an account or nil if there is none
+func GetDeadLetter(accountID string) (*FailedRefresh, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	return getFailedRefresh(svc, "whaling-refresh-deadletters", accountID)
+}
+
+func getFailedRefresh(svc *dynamodb.DynamoDB, table, accountID string) (*FailedRefresh, error) {
+	result, err := svc.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(table),
+		ConsistentRead: aws.Bool(true),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Item) == 0 {
+		return nil, nil
+	}
+
+	item := FailedRefresh{}
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &item); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return &item, nil
+}
+
+// DeleteDeadLetter removes a dead-lettered refresh, usually after it was replayed
+func DeleteDeadLetter(accountID string) error {
+	return deleteFailedRefresh("whaling-refresh-deadletters", accountID)
+}
+
+func deleteFailedRefresh(table, accountID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+	})
+	return err
+}
+
+// RetryEvent creates the refresh event for the next attempt of a failed refresh
+func (f *FailedRefresh) RetryEvent(s *Subscriber) RefreshEvent {
+	ev := s.RefreshEvent()
+	ev.Attempt = f.Attempt
+	ev.FirstFailedAt = f.FirstFailedAt
+	return ev
+}
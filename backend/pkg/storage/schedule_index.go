@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+const (
+	// ScheduleIndex is the global secondary index of active subscribers, partitioned by ScheduleShard and sorted by LastScheduled
+	ScheduleIndex = "schedule-index"
+	// ScheduleShards is the number of partitions the schedule index is spread across
+	ScheduleShards = 8
+)
+
+// ScheduleShardOf returns the schedule index partition of an account
+func ScheduleShardOf(accountID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(accountID))
+	return int(h.Sum32() % ScheduleShards)
+}
+
+// BackfillScheduleIndex adds active subscribers that were created before the schedule index existed to it, and removes
+// inactive ones. It scans the whole table and returns the number of subscribers that were changed.
+func BackfillScheduleIndex(dryRun bool) (int, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	changed := 0
+	var pageErr error
+	err := svc.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String("whaling-subscribers"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var subscribers []*Subscriber
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &subscribers); err != nil {
+			pageErr = err
+			return false
+		}
+
+		for _, subscriber := range subscribers {
+			indexed := subscriber.ScheduleShard != nil
+			if indexed == subscriber.Active {
+				continue
+			}
+
+			log.Printf("BackfillScheduleIndex: accountId=%s active=%t indexed=%t dryRun=%t", subscriber.AccountID, subscriber.Active, indexed, dryRun)
+			changed++
+			if dryRun {
+				continue
+			}
+
+			if err := SetSubscriberActive(subscriber.AccountID, subscriber.Active); err != nil {
+				pageErr = fmt.Errorf("could not update accountId=%s: %v", subscriber.AccountID, err)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return changed, err
+	}
+	return changed, pageErr
+}
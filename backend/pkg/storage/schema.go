@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"strconv"
+)
+
+// Document is the raw JSON of the public subscriber data, as read by migrations
+type Document map[string]interface{}
+
+// Migration upgrades a document from one schema version to the next
+type Migration struct {
+	// From is the version the migration is applied to, the document has version From+1 afterwards
+	From        int
+	Description string
+	Migrate     func(doc Document) error
+}
+
+// Migrations are applied in order to documents with an older SchemaVersion. Documents written before there was a
+// SchemaVersion have version 0. New migrations are appended, the last one defines CurrentSchemaVersion.
+var Migrations = []Migration{
+	{
+		From:        0,
+		Description: "write resources by ID and key the resource totals by resource",
+		Migrate:     migrateResourceIDs,
+	},
+	{
+		From:        1,
+		Description: "add totals for resources that were added after the document was created",
+		Migrate:     migrateMissingResources,
+	},
+	{
+		From:        2,
+		Description: "add the realm of the account",
+		Migrate:     migrateRealm,
+	},
+}
+
+// CurrentSchemaVersion is the version of documents written by this code
+var CurrentSchemaVersion = len(Migrations)
+
+// SchemaError is returned when a document can not be upgraded
+type SchemaError struct {
+	Version int
+	Err     error
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("schema version %d: %v", e.Version, e.Err)
+}
+
+// DecodePublicSubscriberData reads the public data and upgrades it to CurrentSchemaVersion. It returns the version
+// the document was stored with. Documents of a newer version are rejected, so that they are not overwritten
+// by code that does not know about their fields.
+func DecodePublicSubscriberData(raw []byte) (*SubscriberPublicData, int, error) {
+	var doc Document
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, 0, err
+	}
+
+	version, err := schemaVersion(doc)
+	if err != nil {
+		return nil, 0, &SchemaError{Version: version, Err: err}
+	}
+	if version > CurrentSchemaVersion {
+		return nil, version, &SchemaError{Version: version, Err: fmt.Errorf("newer than the supported version %d", CurrentSchemaVersion)}
+	}
+
+	if version < CurrentSchemaVersion {
+		for _, migration := range Migrations[version:] {
+			if err := migration.Migrate(doc); err != nil {
+				return nil, version, &SchemaError{Version: migration.From, Err: fmt.Errorf("%s: %v", migration.Description, err)}
+			}
+		}
+		doc["SchemaVersion"] = CurrentSchemaVersion
+
+		if raw, err = json.Marshal(doc); err != nil {
+			return nil, version, err
+		}
+	}
+
+	var data SubscriberPublicData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, version, &SchemaError{Version: version, Err: err}
+	}
+	return &data, version, nil
+}
+
+func schemaVersion(doc Document) (int, error) {
+	v, ok := doc["SchemaVersion"]
+	if !ok {
+		return 0, nil
+	}
+	n, ok := v.(float64)
+	if !ok || n < 0 {
+		return 0, fmt.Errorf("invalid SchemaVersion %v", v)
+	}
+	return int(n), nil
+}
+
+// resourceID converts a resource that may be stored by its old number to its ID
+func resourceID(v interface{}) (string, error) {
+	var r wows.Resource
+	switch t := v.(type) {
+	case float64:
+		if err := r.UnmarshalText([]byte(strconv.FormatFloat(t, 'f', -1, 64))); err != nil {
+			return "", err
+		}
+	case string:
+		if err := r.UnmarshalText([]byte(t)); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("invalid resource %v", v)
+	}
+	return r.ID(), nil
+}
+
+func migrateResourceIDs(doc Document) error {
+	if list, ok := doc["Resources"].([]interface{}); ok {
+		totals := map[string]interface{}{}
+		for _, item := range list {
+			total, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, err := resourceID(total["Type"])
+			if err != nil {
+				return err
+			}
+			total["Type"] = id
+			totals[id] = total
+		}
+		doc["Resources"] = totals
+	}
+
+	ships, _ := doc["Ships"].(map[string]interface{})
+	for shipID, item := range ships {
+		ship, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		resource, ok := ship["Resource"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, err := resourceID(resource["Type"])
+		if err != nil {
+			return fmt.Errorf("ship %s: %v", shipID, err)
+		}
+		resource["Type"] = id
+	}
+	return nil
+}
+
+func migrateMissingResources(doc Document) error {
+	totals, ok := doc["Resources"].(map[string]interface{})
+	if !ok {
+		totals = map[string]interface{}{}
+		doc["Resources"] = totals
+	}
+
+	for _, r := range wows.Resources {
+		if _, ok := totals[r.ID()]; !ok {
+			totals[r.ID()] = map[string]interface{}{"Type": r.ID(), "Amount": 0, "Earned": 0}
+		}
+	}
+	return nil
+}
+
+func migrateRealm(doc Document) error {
+	if realm, _ := doc["Realm"].(string); realm != "" {
+		return nil
+	}
+
+	accountID, _ := doc["AccountID"].(string)
+	doc["Realm"] = realmFromAccountID(accountID)
+	return nil
+}
+
+// realmFromAccountID returns the realm of the account, or an empty string for invalid IDs
+func realmFromAccountID(accountID string) string {
+	id, err := strconv.ParseInt(accountID, 10, 64)
+	if err != nil {
+		return ""
+	}
+	return wows.RealmFromAccountID(id)
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"fmt"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"testing"
+)
+
+func TestDecodePublicSubscriberData(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		version int
+		// errVersion is the version of the expected SchemaError, -1 if the document must decode
+		errVersion int
+		check      func(t *testing.T, data *SubscriberPublicData)
+	}{
+		{
+			name: "v0 list of totals",
+			raw: `{"AccountID":"534000000","Resources":[{"Type":1,"Amount":3000,"Earned":1500}],
+				"Ships":{"4181604048":{"Resource":{"Type":2,"Amount":100,"Earned":100}}}}`,
+			version:    0,
+			errVersion: -1,
+			check: func(t *testing.T, data *SubscriberPublicData) {
+				coal := data.Resources[wows.Coal]
+				if coal == nil || coal.Type != wows.Coal || coal.Amount != 3000 || coal.Earned != 1500 {
+					t.Errorf("coal total = %+v", coal)
+				}
+				// Resources that are not in the document get an empty total
+				for _, r := range wows.Resources {
+					if data.Resources[r] == nil {
+						t.Errorf("missing total of %s", r)
+					}
+				}
+				ship := data.Ships[4181604048]
+				if ship == nil || ship.Resource.Type != wows.Steel || ship.Resource.Earned != 100 {
+					t.Errorf("ship = %+v", ship)
+				}
+				if data.Realm != "eu" {
+					t.Errorf("Realm = %q, want eu", data.Realm)
+				}
+			},
+		},
+		{
+			name:       "unknown numeric resource",
+			raw:        `{"AccountID":"534000000","Resources":[{"Type":999,"Amount":1,"Earned":0}]}`,
+			version:    0,
+			errVersion: 0,
+		},
+		{
+			name:       "unknown numeric resource of a ship",
+			raw:        `{"AccountID":"534000000","Ships":{"4181604048":{"Resource":{"Type":999}}}}`,
+			version:    0,
+			errVersion: 0,
+		},
+		{
+			name:       "newer schema version",
+			raw:        fmt.Sprintf(`{"SchemaVersion":%d,"AccountID":"534000000"}`, CurrentSchemaVersion+1),
+			version:    CurrentSchemaVersion + 1,
+			errVersion: CurrentSchemaVersion + 1,
+		},
+		{
+			name:       "invalid schema version",
+			raw:        `{"SchemaVersion":"3","AccountID":"534000000"}`,
+			version:    0,
+			errVersion: 0,
+		},
+		{
+			name:       "realm backfill",
+			raw:        `{"SchemaVersion":2,"AccountID":"1000000001","Resources":{}}`,
+			version:    2,
+			errVersion: -1,
+			check: func(t *testing.T, data *SubscriberPublicData) {
+				if data.Realm != "com" {
+					t.Errorf("Realm = %q, want com", data.Realm)
+				}
+			},
+		},
+		{
+			name:       "realm backfill keeps a stored realm",
+			raw:        `{"SchemaVersion":2,"AccountID":"1000000001","Realm":"eu","Resources":{}}`,
+			version:    2,
+			errVersion: -1,
+			check: func(t *testing.T, data *SubscriberPublicData) {
+				if data.Realm != "eu" {
+					t.Errorf("Realm = %q, want eu", data.Realm)
+				}
+			},
+		},
+		{
+			name:       "realm backfill of an invalid account ID",
+			raw:        `{"SchemaVersion":2,"AccountID":"invalid","Resources":{}}`,
+			version:    2,
+			errVersion: -1,
+			check: func(t *testing.T, data *SubscriberPublicData) {
+				if data.Realm != "" {
+					t.Errorf("Realm = %q, want it empty", data.Realm)
+				}
+			},
+		},
+		{
+			name:       "current version",
+			raw:        fmt.Sprintf(`{"SchemaVersion":%d,"AccountID":"2000000001","Realm":"asia","Resources":{"coal":{"Type":"coal","Amount":10,"Earned":5}}}`, CurrentSchemaVersion),
+			version:    CurrentSchemaVersion,
+			errVersion: -1,
+			check: func(t *testing.T, data *SubscriberPublicData) {
+				if coal := data.Resources[wows.Coal]; coal == nil || coal.Earned != 5 {
+					t.Errorf("coal total = %+v", coal)
+				}
+				// Nothing is migrated, missing totals are only added by the migration from version 1
+				if len(data.Resources) != 1 {
+					t.Errorf("Resources = %v, want only coal", data.Resources)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, version, err := DecodePublicSubscriberData([]byte(tt.raw))
+			if version != tt.version {
+				t.Errorf("version = %d, want %d", version, tt.version)
+			}
+
+			if tt.errVersion >= 0 {
+				schemaErr, ok := err.(*SchemaError)
+				if !ok {
+					t.Fatalf("error = %v, want a SchemaError", err)
+				}
+				if schemaErr.Version != tt.errVersion {
+					t.Errorf("SchemaError.Version = %d, want %d", schemaErr.Version, tt.errVersion)
+				}
+				if data != nil {
+					t.Error("data must be nil on errors")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data.SchemaVersion != CurrentSchemaVersion {
+				t.Errorf("SchemaVersion = %d, want %d", data.SchemaVersion, CurrentSchemaVersion)
+			}
+			tt.check(t, data)
+		})
+	}
+}
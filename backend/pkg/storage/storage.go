@@ -1,13 +1,13 @@
 package storage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
+	"rukenshia/frenchwhaling/pkg/queue"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/rs/xid"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +22,10 @@ type RefreshEvent struct {
 	AccessToken          string
 	AccessTokenExpiresAt int64
 	DataURL              string
+
+	// Attempt is the number of failed attempts when the event is a retry, see ScheduleRetry
+	Attempt       int   `json:",omitempty"`
+	FirstFailedAt int64 `json:",omitempty"`
 }
 
 type Subscriber struct {
@@ -34,6 +38,16 @@ type Subscriber struct {
 
 	LastUpdated   int64
 	LastScheduled int64
+	// LastBattleTime is the Unix timestamp of the last battle of any ship, see SetSubscriberLastUpdated
+	LastBattleTime int64
+
+	// ScheduleShard puts active subscribers into the schedule index, it is removed when they are deactivated
+	ScheduleShard *int `json:",omitempty"`
+
+	// DataRevision is the last claimed revision of the public data, see UpdatePublicSubscriberData
+	DataRevision        int64
+	DataRevisionUpdated int64
+	DataCommitted       int64
 }
 
 func GetSubscriber(accountId string) (*Subscriber, error) {
@@ -90,6 +104,7 @@ func FindOrCreateUpdateSubscriber(accessToken string, accessTokenExpiresAt int64
 			LastScheduled:        time.Now().UnixNano(),
 			LastUpdated:          0,
 			Active:               true,
+			ScheduleShard:        aws.Int(ScheduleShardOf(accountId)),
 		}
 
 		av, err := dynamodbattribute.MarshalMap(subscriber)
@@ -117,20 +132,14 @@ func FindOrCreateUpdateSubscriber(accessToken string, accessTokenExpiresAt int64
 		return nil, false, fmt.Errorf("Failed to unmarshal Record: %v", err)
 	}
 
-	// Update the access token
+	// Update the access token. Only the token attributes are updated to not overwrite
+	// changes made by a refresh running at the same time
 	if item.AccessToken != accessToken {
 		log.Printf("FindOrCreateUpdateSubscriber: updating access token accountId=%s", accountId)
 		item.AccessToken = accessToken
+		item.AccessTokenExpiresAt = accessTokenExpiresAt
 
-		av, err := dynamodbattribute.MarshalMap(item)
-		if err != nil {
-			return nil, false, err
-		}
-
-		if _, err := svc.PutItem(&dynamodb.PutItemInput{
-			TableName: aws.String("whaling-subscribers"),
-			Item:      av,
-		}); err != nil {
+		if err := SetSubscriberAccessToken(accountId, accessToken, accessTokenExpiresAt); err != nil {
 			return nil, false, err
 		}
 	}
@@ -141,56 +150,43 @@ func getUniqueAccountURL(accountID string) string {
 	return fmt.Sprintf("https://whaling.in.fkn.space/data/%s/%s%s.json", accountID, xid.New().String(), xid.New().String())
 }
 
+// TriggerRefresh queues scheduled refreshes
 func TriggerRefresh(r []RefreshEvent) error {
-	client := sns.New(session.New())
-
-	data, err := json.Marshal(r)
-	if err != nil {
-		return err
-	}
-
-	_, err = client.Publish(&sns.PublishInput{
-		Message:  aws.String(string(data)),
-		TopicArn: aws.String(os.Getenv("TOPIC_ARN")),
-		MessageAttributes: map[string]*sns.MessageAttributeValue{
-			"Type": &sns.MessageAttributeValue{
-				DataType:    aws.String("String"),
-				StringValue: aws.String("Refresh"),
-			},
-		},
-	})
-
-	return err
+	return publishRefresh(queue.Scheduled, r)
 }
 
+// TriggerRefresh queues a manual refresh of the subscriber, which is processed before scheduled ones
 func (s *Subscriber) TriggerRefresh() error {
-	client := sns.New(session.New())
+	return publishRefresh(queue.Manual, []RefreshEvent{s.RefreshEvent()})
+}
 
-	r := []RefreshEvent{RefreshEvent{
+// RefreshEvent creates the event to refresh the subscriber
+func (s *Subscriber) RefreshEvent() RefreshEvent {
+	return RefreshEvent{
 		AccountID:            s.AccountID,
 		Realm:                s.Realm,
 		AccessToken:          s.AccessToken,
 		AccessTokenExpiresAt: s.AccessTokenExpiresAt,
 		DataURL:              s.DataURL,
-	}}
+	}
+}
 
-	data, err := json.Marshal(r)
+func publishRefresh(lane queue.Lane, r []RefreshEvent) error {
+	publisher, err := queue.NewPublisher()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.Publish(&sns.PublishInput{
-		Message:  aws.String(string(data)),
-		TopicArn: aws.String(os.Getenv("TOPIC_ARN")),
-		MessageAttributes: map[string]*sns.MessageAttributeValue{
-			"Type": &sns.MessageAttributeValue{
-				DataType:    aws.String("String"),
-				StringValue: aws.String("ManualRefresh"),
-			},
-		},
-	})
+	messages := make([]queue.Message, 0, len(r))
+	for _, ev := range r {
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, queue.Message{Lane: lane, Key: ev.AccountID, Body: data})
+	}
 
-	return err
+	return publisher.Publish(context.Background(), messages)
 }
 
 // SetSubscriberActive sets the status of a subscriber to indicate whether they should be scheduled
@@ -201,7 +197,7 @@ func SetSubscriberActive(accountID string, active bool) error {
 	sess := session.Must(session.NewSession())
 	svc := dynamodb.New(sess)
 
-	_, err := svc.UpdateItem(&dynamodb.UpdateItemInput{
+	input := &dynamodb.UpdateItemInput{
 		TableName: aws.String("whaling-subscribers"),
 		Key: map[string]*dynamodb.AttributeValue{
 			"AccountID": {
@@ -213,8 +209,17 @@ func SetSubscriberActive(accountID string, active bool) error {
 				BOOL: aws.Bool(active),
 			},
 		},
-		UpdateExpression: aws.String("set Active = :a"),
-	})
+		// Inactive subscribers are taken out of the schedule index
+		UpdateExpression: aws.String("set Active = :a remove ScheduleShard"),
+	}
+	if active {
+		input.ExpressionAttributeValues[":s"] = &dynamodb.AttributeValue{
+			N: aws.String(fmt.Sprintf("%d", ScheduleShardOf(accountID))),
+		}
+		input.UpdateExpression = aws.String("set Active = :a, ScheduleShard = :s")
+	}
+
+	_, err := svc.UpdateItem(input)
 	return err
 }
 
@@ -242,7 +247,9 @@ func SetSubscriberAccessToken(accountID, accessToken string, expiresAt int64) er
 	return err
 }
 
-func SetSubscriberLastUpdated(accountID string, timestamp int64) error {
+// SetSubscriberLastUpdated stores when the data of the subscriber was refreshed and the time of their
+// last battle in seconds, which the scheduler uses to decide how often they are refreshed
+func SetSubscriberLastUpdated(accountID string, timestamp, lastBattleTime int64) error {
 	sess := session.Must(session.NewSession())
 	svc := dynamodb.New(sess)
 
@@ -257,8 +264,11 @@ func SetSubscriberLastUpdated(accountID string, timestamp int64) error {
 			":l": {
 				N: aws.String(fmt.Sprintf("%d", timestamp)),
 			},
+			":b": {
+				N: aws.String(fmt.Sprintf("%d", lastBattleTime)),
+			},
 		},
-		UpdateExpression: aws.String("set LastUpdated = :l"),
+		UpdateExpression: aws.String("set LastUpdated = :l, LastBattleTime = :b"),
 	})
 	return err
 }
@@ -284,50 +294,58 @@ func SetSubscriberLastScheduled(accountID string, timestamp int64) error {
 	return err
 }
 
-func getPage(lastEvaluated map[string]*dynamodb.AttributeValue, notScheduledSince int64) ([]*Subscriber, error) {
+// FindUnscheduledSubscribers pages through the active subscribers that were not scheduled since notScheduledSince.
+// Every page is handed to fn as soon as it was read, returning an error from fn stops the search.
+//
+// The subscribers are read from the schedule index, which only contains active subscribers sorted by
+// LastScheduled, so the cost depends on the number of subscribers that are due and not on the size of the table.
+func FindUnscheduledSubscribers(notScheduledSince int64, fn func(subscribers []*Subscriber) error) error {
 	sess := session.Must(session.NewSession())
 	svc := dynamodb.New(sess)
 
-	out, err := svc.Scan(&dynamodb.ScanInput{
-		TableName: aws.String("whaling-subscribers"),
-		ExpressionAttributeNames: map[string]*string{
-			"#ls": aws.String("LastScheduled"),
-			"#a":  aws.String("Active"),
-		},
-		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
-			":t": {
-				N: aws.String(fmt.Sprintf("%d", notScheduledSince)),
-			},
-			":f": {
-				BOOL: aws.Bool(true),
-			},
-		},
-		FilterExpression:       aws.String("#ls < :t AND #a = :f"),
-		ReturnConsumedCapacity: aws.String("TOTAL"),
-		ExclusiveStartKey:      lastEvaluated,
-	})
-	if err != nil {
-		return nil, err
-	}
-	log.Printf("FindUnscheduledSubscribers: count=%d scanned=%d capacity=%f", *out.Count, *out.ScannedCount, *out.ConsumedCapacity.CapacityUnits)
-
-	var subscribers []*Subscriber
-	if err := dynamodbattribute.UnmarshalListOfMaps(out.Items, &subscribers); err != nil {
-		return nil, err
-	}
-
-	if out.LastEvaluatedKey != nil {
-		subs, err := getPage(out.LastEvaluatedKey, notScheduledSince)
-		if err != nil {
-			return nil, err
+	for shard := 0; shard < ScheduleShards; shard++ {
+		var lastEvaluated map[string]*dynamodb.AttributeValue
+		for {
+			out, err := svc.Query(&dynamodb.QueryInput{
+				TableName: aws.String("whaling-subscribers"),
+				IndexName: aws.String(ScheduleIndex),
+				ExpressionAttributeNames: map[string]*string{
+					"#s":  aws.String("ScheduleShard"),
+					"#ls": aws.String("LastScheduled"),
+				},
+				ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+					":s": {
+						N: aws.String(fmt.Sprintf("%d", shard)),
+					},
+					":t": {
+						N: aws.String(fmt.Sprintf("%d", notScheduledSince)),
+					},
+				},
+				KeyConditionExpression: aws.String("#s = :s AND #ls < :t"),
+				ReturnConsumedCapacity: aws.String("TOTAL"),
+				ExclusiveStartKey:      lastEvaluated,
+			})
+			if err != nil {
+				return err
+			}
+			log.Printf("FindUnscheduledSubscribers: shard=%d count=%d capacity=%f", shard, *out.Count, *out.ConsumedCapacity.CapacityUnits)
+
+			var subscribers []*Subscriber
+			if err := dynamodbattribute.UnmarshalListOfMaps(out.Items, &subscribers); err != nil {
+				return err
+			}
+
+			if len(subscribers) > 0 {
+				if err := fn(subscribers); err != nil {
+					return err
+				}
+			}
+
+			if out.LastEvaluatedKey == nil {
+				break
+			}
+			lastEvaluated = out.LastEvaluatedKey
 		}
-
-		subscribers = append(subscribers, subs...)
 	}
-
-	return subscribers, nil
-}
-
-func FindUnscheduledSubscribers(notScheduledSince int64) ([]*Subscriber, error) {
-	return getPage(nil, notScheduledSince)
+	return nil
 }
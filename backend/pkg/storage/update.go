@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+var (
+	// ErrConflict is returned when the subscriber data kept changing while trying to update it
+	ErrConflict = errors.New("subscriber data was modified concurrently")
+
+	// MaxUpdateAttempts is how often an update is retried on a conflict
+	MaxUpdateAttempts = 5
+	// StaleClaimAfter is the time after which a revision that was claimed but never written is given up. It is longer
+	// than any writer may run (lambda functions stop after 15 minutes), the save is fenced in case one still does.
+	StaleClaimAfter = 20 * time.Minute
+)
+
+// UpdateError tells which step of UpdatePublicSubscriberData failed
+type UpdateError struct {
+	// Op is one of "load", "update" or "save"
+	Op  string
+	Err error
+}
+
+func (e *UpdateError) Error() string {
+	return fmt.Sprintf("%s subscriber data: %v", e.Op, e.Err)
+}
+
+// UpdateFunc changes the subscriber data. It may be called several times with freshly loaded data
+// when other writers change the data at the same time, so it must not have side effects.
+type UpdateFunc func(data *SubscriberPublicData, isNew bool) error
+
+// UpdatePublicSubscriberData loads the public data of a subscriber, applies the update and saves it.
+//
+// The subscriber item in DynamoDB tracks the revision of the data. Before saving, the next revision is claimed
+// with a conditional update. If another writer got there first, the data is loaded again and the update is
+// applied once more, so that no change is lost. A writer that stalled until its claim was taken over is
+// stopped by the save, which only succeeds if the object was not changed since it was loaded.
+func UpdatePublicSubscriberData(ctx context.Context, accountID, dataURL string, update UpdateFunc) (*SubscriberPublicData, error) {
+	for attempt := 1; ; attempt++ {
+		isNew := false
+		data, etag, err := loadPublicSubscriberData(ctx, dataURL)
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+				data = NewSubscriberPublicData(accountID)
+				isNew = true
+			} else {
+				return nil, &UpdateError{Op: "load", Err: err}
+			}
+		}
+
+		if err := update(data, isNew); err != nil {
+			return nil, &UpdateError{Op: "update", Err: err}
+		}
+
+		revision := data.Revision
+		err = claimRevision(accountID, revision)
+		if err == nil {
+			data.Revision = revision + 1
+			err = data.saveIfUnchanged(ctx, dataURL, isNew, etag)
+		}
+		if err != nil {
+			if err != ErrConflict {
+				return nil, &UpdateError{Op: "save", Err: err}
+			}
+
+			if attempt >= MaxUpdateAttempts {
+				return nil, &UpdateError{Op: "save", Err: ErrConflict}
+			}
+
+			log.Printf("UpdatePublicSubscriberData: conflict, retrying accountId=%s revision=%d attempt=%d", accountID, revision, attempt)
+			if err := sleepBackoff(ctx, attempt); err != nil {
+				return nil, &UpdateError{Op: "save", Err: err}
+			}
+			continue
+		}
+
+		if err := commitRevision(accountID, data.Revision); err != nil {
+			// Not fatal: the next writer reads the new revision from S3
+			log.Printf("WARN: UpdatePublicSubscriberData: could not mark revision as committed accountId=%s revision=%d error=%v", accountID, data.Revision, err)
+		}
+
+		return data, nil
+	}
+}
+
+// claimRevision moves the revision of the subscriber from current to current+1. It fails with ErrConflict if
+// somebody else claimed it first, unless that claim was never written and is older than StaleClaimAfter.
+func claimRevision(accountID string, current int64) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	now := time.Now()
+	_, err := svc.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName: aws.String("whaling-subscribers"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+		ExpressionAttributeNames: map[string]*string{
+			"#r": aws.String("DataRevision"),
+			"#u": aws.String("DataRevisionUpdated"),
+			"#c": aws.String("DataCommitted"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":current": {
+				N: aws.String(fmt.Sprintf("%d", current)),
+			},
+			":next": {
+				N: aws.String(fmt.Sprintf("%d", current+1)),
+			},
+			":now": {
+				N: aws.String(fmt.Sprintf("%d", now.UnixNano())),
+			},
+			":stale": {
+				N: aws.String(fmt.Sprintf("%d", now.Add(-StaleClaimAfter).UnixNano())),
+			},
+		},
+		ConditionExpression: aws.String("attribute_exists(AccountID) AND (attribute_not_exists(#r) OR #r = :current OR ((#c = :current OR attribute_not_exists(#c)) AND #u < :stale))"),
+		UpdateExpression:    aws.String("set #r = :next, #u = :now"),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return ErrConflict
+	}
+	return err
+}
+
+// commitRevision marks the revision as written to S3
+func commitRevision(accountID string, revision int64) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName: aws.String("whaling-subscribers"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":c": {
+				N: aws.String(fmt.Sprintf("%d", revision)),
+			},
+		},
+		UpdateExpression: aws.String("set DataCommitted = :c"),
+	})
+	return err
+}
+
+func sleepBackoff(ctx context.Context, attempt int) error {
+	delay := time.Duration(50<<uint(attempt)) * time.Millisecond
+	delay += time.Duration(rand.Int63n(int64(delay)))
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
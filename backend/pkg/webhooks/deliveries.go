@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// DeliveryLogRetention is how long delivery logs are kept
+var DeliveryLogRetention = 14 * 24 * time.Hour
+
+// DeliveryLog records a single delivery of an event to an endpoint
+type DeliveryLog struct {
+	EndpointID string
+	Timestamp  int64
+	DeliveryID string
+	AccountID  string
+	EventType  string
+	Success    bool
+	StatusCode int
+	Attempts   int
+	Error      string `json:",omitempty"`
+	DurationMs int64
+	// ExpiresAt is the Unix timestamp at which DynamoDB deletes the log
+	ExpiresAt int64
+	// NextAttemptAt is when a failed delivery is sent again, it is only set while a redelivery is pending
+	NextAttemptAt int64 `json:",omitempty"`
+	// Redeliveries is the number of times the delivery was sent again from the log
+	Redeliveries int `json:",omitempty"`
+	// Payload is the body of a delivery that is sent again, it is removed once the delivery is done
+	Payload string `json:",omitempty"`
+}
+
+// AddDeliveryLog stores the log of a delivery, replacing the log of the same delivery
+func AddDeliveryLog(l *DeliveryLog) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	l.ExpiresAt = time.Unix(0, l.Timestamp).Add(DeliveryLogRetention).Unix()
+	av, err := dynamodbattribute.MarshalMap(l)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String("whaling-webhook-deliveries"),
+		Item:      av,
+	})
+	return err
+}
+
+// GetDeliveryLogs returns the most recent deliveries to an endpoint, newest first
+func GetDeliveryLogs(endpointID string, limit int64) ([]*DeliveryLog, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	out, err := svc.Query(&dynamodb.QueryInput{
+		TableName: aws.String("whaling-webhook-deliveries"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":e": {
+				S: aws.String(endpointID),
+			},
+		},
+		KeyConditionExpression: aws.String("EndpointID = :e"),
+		ScanIndexForward:       aws.Bool(false),
+		Limit:                  aws.Int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*DeliveryLog
+	if err := dynamodbattribute.UnmarshalListOfMaps(out.Items, &logs); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return logs, nil
+}
+
+// FindDueRedeliveries returns the failed deliveries that should be sent again now
+func FindDueRedeliveries(now int64) ([]*DeliveryLog, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	var logs []*DeliveryLog
+	var pageErr error
+	err := svc.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String("whaling-webhook-deliveries"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":n": {
+				N: aws.String(fmt.Sprintf("%d", now)),
+			},
+		},
+		FilterExpression: aws.String("NextAttemptAt <= :n"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		var items []*DeliveryLog
+		if err := dynamodbattribute.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			pageErr = fmt.Errorf("Failed to unmarshal Record: %v", err)
+			return false
+		}
+		logs = append(logs, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logs, pageErr
+}
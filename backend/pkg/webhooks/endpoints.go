@@ -0,0 +1,191 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/rs/xid"
+)
+
+// MaxEndpoints is the number of endpoints a subscriber can register
+const MaxEndpoints = 5
+
+var (
+	ErrInvalidURL       = errors.New("webhook url must be an absolute https url")
+	ErrUnknownEventType = errors.New("unknown event type")
+	ErrNoEventTypes     = errors.New("at least one event type is required")
+	ErrTooManyEndpoints = fmt.Errorf("at most %d endpoints can be registered", MaxEndpoints)
+)
+
+// EventTypes are the events that can be subscribed to
+var EventTypes = []string{"ResourceEarned", "ShipAddition", "ShipRemoval", "RefreshCompleted"}
+
+// Endpoint is a URL a subscriber wants events to be sent to
+type Endpoint struct {
+	AccountID  string
+	EndpointID string
+	URL        string
+	EventTypes []string
+	// Secret is used to sign the payloads, it is only shown when the endpoint is created
+	Secret    string
+	CreatedAt int64
+}
+
+// Wants returns whether the endpoint subscribed to the event type
+func (e *Endpoint) Wants(eventType string) bool {
+	for _, t := range e.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the URL and event types of the endpoint
+func (e *Endpoint) Validate() error {
+	u, err := url.Parse(e.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidURL
+	}
+
+	if len(e.EventTypes) == 0 {
+		return ErrNoEventTypes
+	}
+	for _, t := range e.EventTypes {
+		known := false
+		for _, eventType := range EventTypes {
+			known = known || t == eventType
+		}
+		if !known {
+			return fmt.Errorf("%v: %s", ErrUnknownEventType, t)
+		}
+	}
+	return nil
+}
+
+// CreateEndpoint validates and stores a new endpoint with a fresh secret
+func CreateEndpoint(accountID, endpointURL string, eventTypes []string) (*Endpoint, error) {
+	endpoint := &Endpoint{
+		AccountID:  accountID,
+		EndpointID: xid.New().String(),
+		URL:        endpointURL,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now().UnixNano(),
+	}
+	if err := endpoint.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := GetEndpoints(accountID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxEndpoints {
+		return nil, ErrTooManyEndpoints
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	endpoint.Secret = hex.EncodeToString(secret)
+
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	av, err := dynamodbattribute.MarshalMap(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := svc.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String("whaling-webhooks"),
+		Item:      av,
+	}); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+// GetEndpoints returns all endpoints of an account
+func GetEndpoints(accountID string) ([]*Endpoint, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	out, err := svc.Query(&dynamodb.QueryInput{
+		TableName: aws.String("whaling-webhooks"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":a": {
+				S: aws.String(accountID),
+			},
+		},
+		KeyConditionExpression: aws.String("AccountID = :a"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []*Endpoint
+	if err := dynamodbattribute.UnmarshalListOfMaps(out.Items, &endpoints); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return endpoints, nil
+}
+
+// GetEndpoint returns an endpoint of an account or nil if there is none
+func GetEndpoint(accountID, endpointID string) (*Endpoint, error) {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	result, err := svc.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String("whaling-webhooks"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+			"EndpointID": {
+				S: aws.String(endpointID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Item) == 0 {
+		return nil, nil
+	}
+
+	endpoint := Endpoint{}
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &endpoint); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal Record: %v", err)
+	}
+	return &endpoint, nil
+}
+
+// DeleteEndpoint removes an endpoint of an account
+func DeleteEndpoint(accountID, endpointID string) error {
+	sess := session.Must(session.NewSession())
+	svc := dynamodb.New(sess)
+
+	_, err := svc.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String("whaling-webhooks"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"AccountID": {
+				S: aws.String(accountID),
+			},
+			"EndpointID": {
+				S: aws.String(endpointID),
+			},
+		},
+	})
+	return err
+}
@@ -0,0 +1,50 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+var (
+	// MaxRedeliveries is the number of times a failed delivery is sent again from the delivery log
+	MaxRedeliveries = 5
+	// RedeliveryBaseDelay is the delay before the first redelivery, every further redelivery waits four times as long
+	RedeliveryBaseDelay = time.Minute
+	// RedeliveryMaxDelay caps the delay between two redeliveries
+	RedeliveryMaxDelay = 2 * time.Hour
+)
+
+// RedeliveryDelay returns how long to wait before the given redelivery
+func RedeliveryDelay(redelivery int) time.Duration {
+	delay := RedeliveryBaseDelay
+	for i := 1; i < redelivery; i++ {
+		delay *= 4
+		if delay >= RedeliveryMaxDelay {
+			return RedeliveryMaxDelay
+		}
+	}
+	return delay
+}
+
+// Redeliver sends a failed delivery from the log again, with the same delivery ID and a fresh signature, and
+// stores the result in the log. The delivery is given up once the endpoint was deleted.
+func (d *Dispatcher) Redeliver(ctx context.Context, entry *DeliveryLog) error {
+	endpoint, err := GetEndpoint(entry.AccountID, entry.EndpointID)
+	if err != nil {
+		return err
+	}
+
+	entry.Redeliveries++
+	if endpoint == nil {
+		log.Printf("Redeliver: endpoint was deleted, giving up accountId=%s endpointId=%s deliveryId=%s", entry.AccountID, entry.EndpointID, entry.DeliveryID)
+		entry.record(nil, fmt.Errorf("endpoint was deleted"), nil, time.Now())
+	} else {
+		body := []byte(entry.Payload)
+		delivery, err := d.send(ctx, endpoint, entry.DeliveryID, body)
+		entry.record(delivery, err, body, time.Now())
+	}
+
+	return AddDeliveryLog(entry)
+}
@@ -0,0 +1,165 @@
+// Package webhooks sends the events of a subscriber to endpoints they registered, so that third parties can
+// integrate with the refreshes without polling the public data.
+//
+// Every payload is signed with the secret of the endpoint: the signature header contains the hex encoded
+// HMAC-SHA256 of the timestamp header, a dot and the raw body.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	// SignatureHeader contains the signature of the payload, see Sign
+	SignatureHeader = "X-Whaling-Signature"
+	// TimestampHeader contains the Unix timestamp the payload was signed at
+	TimestampHeader = "X-Whaling-Timestamp"
+	// DeliveryHeader contains the ID of the delivery, it is the same for all attempts
+	DeliveryHeader = "X-Whaling-Delivery"
+)
+
+// Event is any of the events in pkg/events
+type Event interface {
+	EventType() string
+}
+
+// Payload is the body sent to an endpoint
+type Payload struct {
+	ID   string
+	Type string
+	Data Event
+}
+
+// Sign returns the signature of a body sent at the timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a body received with the timestamp, as receivers of the payloads should
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Dispatcher delivers events to the endpoints of subscribers
+type Dispatcher struct {
+	Client *http.Client
+	Retry  notify.Retry
+}
+
+// NewDispatcher creates a dispatcher with a short timeout and a single attempt, as it runs as part of the refresh.
+// Failed deliveries are sent again from the delivery log.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		Client: &http.Client{Timeout: 5 * time.Second},
+		Retry:  notify.Retry{Attempts: 1},
+	}
+}
+
+// Dispatch sends the events to all endpoints of the account that subscribed to them. Endpoints are served
+// concurrently, the events of one endpoint are sent in order. Failed deliveries are recorded in the delivery
+// log and sent again from there, the returned error is about finding the endpoints.
+func (d *Dispatcher) Dispatch(ctx context.Context, accountID string, evs []Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+
+	endpoints, err := GetEndpoints(accountID)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		wg.Add(1)
+		go func(endpoint *Endpoint) {
+			defer wg.Done()
+			for _, ev := range evs {
+				if !endpoint.Wants(ev.EventType()) {
+					continue
+				}
+				d.deliver(ctx, endpoint, ev)
+			}
+		}(endpoint)
+	}
+	wg.Wait()
+	return nil
+}
+
+// deliver sends a single event to the endpoint and records the result in the delivery log. Deliveries that
+// failed with a network error, rate limit or server error are sent again later, see Redeliver.
+func (d *Dispatcher) deliver(ctx context.Context, endpoint *Endpoint, ev Event) {
+	entry := &DeliveryLog{
+		EndpointID: endpoint.EndpointID,
+		Timestamp:  time.Now().UnixNano(),
+		DeliveryID: xid.New().String(),
+		AccountID:  endpoint.AccountID,
+		EventType:  ev.EventType(),
+	}
+
+	body, err := json.Marshal(Payload{ID: entry.DeliveryID, Type: ev.EventType(), Data: ev})
+	if err != nil {
+		entry.Error = fmt.Sprintf("could not marshal payload: %v", err)
+		log.Printf("ERROR: could not marshal webhook payload accountId=%s type=%s error=%v", endpoint.AccountID, entry.EventType, err)
+	} else {
+		delivery, err := d.send(ctx, endpoint, entry.DeliveryID, body)
+		entry.record(delivery, err, body, time.Now())
+	}
+
+	if err := AddDeliveryLog(entry); err != nil {
+		log.Printf("WARN: could not store webhook delivery log endpointId=%s error=%v", endpoint.EndpointID, err)
+	}
+}
+
+// record updates the log with the result of an attempt and schedules a redelivery if the attempt may succeed later
+func (l *DeliveryLog) record(delivery *notify.Delivery, err error, body []byte, now time.Time) {
+	if delivery != nil {
+		l.StatusCode = delivery.StatusCode
+		l.Attempts += delivery.Attempts
+		l.DurationMs = int64(delivery.Duration / time.Millisecond)
+	}
+	l.Success = err == nil
+	l.Error = ""
+	l.NextAttemptAt = 0
+	l.Payload = ""
+	if err == nil {
+		return
+	}
+
+	l.Error = err.Error()
+	log.Printf("WARN: webhook delivery failed accountId=%s endpointId=%s type=%s error=%v", l.AccountID, l.EndpointID, l.EventType, err)
+	if delivery != nil && delivery.Retryable() && l.Redeliveries < MaxRedeliveries {
+		l.NextAttemptAt = now.Add(RedeliveryDelay(l.Redeliveries + 1)).UnixNano()
+		l.Payload = string(body)
+	}
+}
+
+// send signs the body and posts it to the endpoint
+func (d *Dispatcher) send(ctx context.Context, endpoint *Endpoint, deliveryID string, body []byte) (*notify.Delivery, error) {
+	timestamp := time.Now().Unix()
+	return notify.Deliver(ctx, d.Client, notify.Request{
+		URL:  endpoint.URL,
+		Body: body,
+		Headers: map[string]string{
+			SignatureHeader: Sign(endpoint.Secret, timestamp, body),
+			TimestampHeader: strconv.FormatInt(timestamp, 10),
+			DeliveryHeader:  deliveryID,
+		},
+	}, d.Retry)
+}
@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"rukenshia/frenchwhaling/pkg/events"
+	"rukenshia/frenchwhaling/pkg/notify"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"ID":"delivery","Type":"ResourceEarned"}`)
+	signature := Sign("secret", 1600000000, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      []byte
+		signature string
+		valid     bool
+	}{
+		{"valid", "secret", 1600000000, body, signature, true},
+		{"other secret", "other", 1600000000, body, signature, false},
+		{"other timestamp", "secret", 1600000001, body, signature, false},
+		{"tampered body", "secret", 1600000000, []byte(`{"ID":"delivery","Type":"ShipRemoval"}`), signature, false},
+		{"missing prefix", "secret", 1600000000, body, signature[len("sha256="):], false},
+		{"empty", "secret", 1600000000, body, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if valid := Verify(tt.secret, tt.timestamp, tt.body, tt.signature); valid != tt.valid {
+				t.Errorf("Verify() = %v, want %v", valid, tt.valid)
+			}
+		})
+	}
+}
+
+func TestSendIsVerifiable(t *testing.T) {
+	endpoint := &Endpoint{AccountID: "500", EndpointID: "endpoint", Secret: "secret"}
+	ev := events.NewResourceEarned("500", "eu", wows.RepublicTokens, 100, 4181604048, "pvp")
+	body, err := json.Marshal(Payload{ID: "delivery", Type: ev.EventType(), Data: ev})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan bool, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		received <- err == nil &&
+			r.Header.Get(DeliveryHeader) == "delivery" &&
+			Verify(endpoint.Secret, timestamp, data, r.Header.Get(SignatureHeader))
+	}))
+	defer srv.Close()
+	endpoint.URL = srv.URL
+
+	d := &Dispatcher{Client: srv.Client(), Retry: notify.Retry{Attempts: 1}}
+	if _, err := d.send(context.Background(), endpoint, "delivery", body); err != nil {
+		t.Fatal(err)
+	}
+	if !<-received {
+		t.Error("the receiver could not verify the delivery")
+	}
+}
+
+func TestRecordSchedulesRedelivery(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	body := []byte(`{}`)
+	failed := errors.New("failed")
+
+	tests := []struct {
+		name         string
+		redeliveries int
+		delivery     *notify.Delivery
+		err          error
+		pending      bool
+	}{
+		{"success", 0, &notify.Delivery{Attempts: 1, StatusCode: 200}, nil, false},
+		{"server error", 0, &notify.Delivery{Attempts: 3, StatusCode: 503}, failed, true},
+		{"network error", 0, &notify.Delivery{Attempts: 3}, failed, true},
+		{"client error", 0, &notify.Delivery{Attempts: 1, StatusCode: 404}, failed, false},
+		{"out of redeliveries", MaxRedeliveries, &notify.Delivery{Attempts: 1, StatusCode: 503}, failed, false},
+		{"endpoint deleted", 1, nil, failed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &DeliveryLog{Redeliveries: tt.redeliveries, Payload: "old", NextAttemptAt: 1}
+			entry.record(tt.delivery, tt.err, body, now)
+
+			if pending := entry.NextAttemptAt != 0; pending != tt.pending {
+				t.Fatalf("pending = %v, want %v", pending, tt.pending)
+			}
+			if tt.pending {
+				if want := now.Add(RedeliveryDelay(tt.redeliveries + 1)).UnixNano(); entry.NextAttemptAt != want {
+					t.Errorf("NextAttemptAt = %d, want %d", entry.NextAttemptAt, want)
+				}
+				if entry.Payload != string(body) {
+					t.Errorf("Payload = %q, want the body", entry.Payload)
+				}
+			} else if entry.Payload != "" {
+				t.Errorf("Payload = %q, want it removed", entry.Payload)
+			}
+			if entry.Success != (tt.err == nil) {
+				t.Errorf("Success = %v", entry.Success)
+			}
+		})
+	}
+}
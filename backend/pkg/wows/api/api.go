@@ -1,10 +1,13 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 
 	resty "github.com/go-resty/resty/v2"
 )
@@ -18,11 +21,40 @@ type ApiResponse struct {
 		Value   string      `json:"value"`
 	} `json:"error"`
 	Meta struct {
-		Count  int         `json:"count"`
-		Hidden interface{} `json:"hidden"`
+		Count     int         `json:"count"`
+		Hidden    interface{} `json:"hidden"`
+		PageTotal int         `json:"page_total"`
+		Page      int         `json:"page"`
 	} `json:"meta"`
 }
 
+var (
+	// ErrHiddenProfile is returned when the account is hidden and the response contains no data for it
+	ErrHiddenProfile = errors.New("profile of the account is hidden")
+	// ErrNoPrivateData is returned when the response does not contain the private data of the account,
+	// for example because the access token does not grant access to it anymore
+	ErrNoPrivateData = errors.New("no private data for the account")
+)
+
+// IsHidden returns whether the account is listed in the hidden accounts of the response
+func (r *ApiResponse) IsHidden(accountID string) bool {
+	hidden, ok := r.Meta.Hidden.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, id := range hidden {
+		// Account IDs are decoded as float64, which fmt would print in exponent notation
+		if number, ok := id.(float64); ok {
+			id = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		if fmt.Sprint(id) == accountID {
+			return true
+		}
+	}
+	return false
+}
+
 type PlayerInfoResponse struct {
 	ApiResponse
 	Data map[string]PlayerInfo `json:"data"`
@@ -46,8 +78,8 @@ type PlayerInfo struct {
 
 type PlayerPortResponse struct {
 	ApiResponse
-	Data map[string]struct {
-		Private struct {
+	Data map[string]*struct {
+		Private *struct {
 			Port []int64 `json:"port"`
 		} `json:"private"`
 	} `json:"data"`
@@ -89,6 +121,11 @@ type ShipStatistics struct {
 	} `json:"oper_solo"`
 }
 
+type EncyclopediaShipsResponse struct {
+	ApiResponse
+	Data map[string]json.RawMessage `json:"data"`
+}
+
 type RefreshAccessTokenResponse struct {
 	ApiResponse
 	Data struct {
@@ -131,12 +168,13 @@ func GetPlayerInfo(realm, accessToken, accountId string) (*PlayerInfo, error) {
 	return &entry, nil
 }
 
-func GetPlayerPort(realm, accessToken, accountId string) ([]int64, error) {
+func GetPlayerPort(ctx context.Context, realm, accessToken, accountId string) ([]int64, error) {
 	client := resty.New()
 
 	log.Printf("GetPlayerPort: accountId=%s realm=%s", accountId, realm)
 
 	res, err := client.R().
+		SetContext(ctx).
 		SetResult(PlayerPortResponse{}).
 		SetQueryParam("application_id", os.Getenv("APPLICATION_ID")).
 		SetQueryParam("account_id", accountId).
@@ -160,15 +198,24 @@ func GetPlayerPort(realm, accessToken, accountId string) ([]int64, error) {
 		return nil, fmt.Errorf("WG API status: %v", data)
 	}
 
-	return data.Data[accountId].Private.Port, nil
+	entry := data.Data[accountId]
+	if entry == nil && data.IsHidden(accountId) {
+		return nil, ErrHiddenProfile
+	}
+	if entry == nil || entry.Private == nil {
+		return nil, ErrNoPrivateData
+	}
+
+	return entry.Private.Port, nil
 }
 
-func GetPlayerShipStatistics(realm, accessToken, accountId string) (map[int64]*ShipStatistics, error) {
+func GetPlayerShipStatistics(ctx context.Context, realm, accessToken, accountId string) (map[int64]*ShipStatistics, error) {
 	client := resty.New()
 
 	log.Printf("GetPlayerShipStatistics: accountId=%s realm=%s", accountId, realm)
 
 	res, err := client.R().
+		SetContext(ctx).
 		SetResult(ShipsStatisticsResponse{}).
 		SetQueryParam("application_id", os.Getenv("APPLICATION_ID")).
 		SetQueryParam("account_id", accountId).
@@ -192,6 +239,11 @@ func GetPlayerShipStatistics(realm, accessToken, accountId string) (map[int64]*S
 		return nil, fmt.Errorf("WG API status: %v", data)
 	}
 
+	if data.Data[accountId] == nil && data.IsHidden(accountId) {
+		return nil, ErrHiddenProfile
+	}
+
+	// Ships can be returned without their private data, callers have to check ShipStatistics.Private
 	shipStatistics := make(map[int64]*ShipStatistics)
 
 	for _, e := range data.Data[accountId] {
@@ -201,12 +253,93 @@ func GetPlayerShipStatistics(realm, accessToken, accountId string) (map[int64]*S
 	return shipStatistics, nil
 }
 
-func RefreshAccessToken(realm, accessToken, accountId string) (*RefreshAccessTokenResponse, error) {
+// GetEncyclopediaShips returns the raw encyclopedia entry of every ship known to the Wargaming API.
+// The entries are left undecoded so that callers can unmarshal them into their own ship model. Every page
+// is requested after wait returned, wait may be nil.
+func GetEncyclopediaShips(ctx context.Context, realm string, wait WaitFunc) ([]json.RawMessage, error) {
+	log.Printf("GetEncyclopediaShips: realm=%s", realm)
+
+	return getEncyclopediaShipPages(ctx, realm, "en", wait, "name,price_gold,nation,is_premium,ship_id,price_credit,tier,type,images,next_ships,has_demo_profile,is_special,group")
+}
+
+// GetEncyclopediaShipNames returns the names of all ships in the given language
+func GetEncyclopediaShipNames(ctx context.Context, realm, language string, wait WaitFunc) (map[int64]string, error) {
+	log.Printf("GetEncyclopediaShipNames: realm=%s language=%s", realm, language)
+
+	entries, err := getEncyclopediaShipPages(ctx, realm, language, wait, "name,ship_id")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int64]string, len(entries))
+	for _, entry := range entries {
+		var ship struct {
+			ShipID int64  `json:"ship_id"`
+			Name   string `json:"name"`
+		}
+		if err := json.Unmarshal(entry, &ship); err != nil {
+			return nil, err
+		}
+		names[ship.ShipID] = ship.Name
+	}
+	return names, nil
+}
+
+// WaitFunc blocks until a request to the realm is allowed, see refresh.RealmLimiter
+type WaitFunc func(ctx context.Context, realm string) error
+
+func getEncyclopediaShipPages(ctx context.Context, realm, language string, wait WaitFunc, fields string) ([]json.RawMessage, error) {
+	client := resty.New()
+
+	var ships []json.RawMessage
+	for page, pageTotal := 1, 1; page <= pageTotal; page++ {
+		if wait != nil {
+			if err := wait(ctx, realm); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := client.R().
+			SetContext(ctx).
+			SetResult(EncyclopediaShipsResponse{}).
+			SetQueryParam("application_id", os.Getenv("APPLICATION_ID")).
+			SetQueryParam("fields", fields).
+			SetQueryParam("language", language).
+			SetQueryParam("limit", "100").
+			SetQueryParam("page_no", fmt.Sprintf("%d", page)).
+			Get(fmt.Sprintf("https://api.worldofwarships.%s/wows/encyclopedia/ships/", realm))
+
+		if err != nil {
+			log.Printf("getEncyclopediaShipPages: error=%v response=%s", err, res.String())
+			return nil, err
+		}
+
+		data, ok := res.Result().(*EncyclopediaShipsResponse)
+		if !ok {
+			log.Printf("getEncyclopediaShipPages: error=parse failed response=%s", res.String())
+			return nil, errors.New("Could not parse response from Wargaming API")
+		}
+
+		if data.Status != "ok" {
+			return nil, fmt.Errorf("WG API status: %v", data)
+		}
+
+		for _, ship := range data.Data {
+			ships = append(ships, ship)
+		}
+		pageTotal = data.Meta.PageTotal
+	}
+
+	return ships, nil
+}
+
+func RefreshAccessToken(ctx context.Context, realm, accessToken, accountId string) (*RefreshAccessTokenResponse, error) {
 	client := resty.New()
 
 	log.Printf("RefreshAccessToken: accountId=%s realm=%s", accountId, realm)
 
 	res, err := client.R().
+		SetContext(ctx).
 		SetResult(RefreshAccessTokenResponse{}).
 		SetFormData(map[string]string{
 			"application_id": os.Getenv("APPLICATION_ID"),
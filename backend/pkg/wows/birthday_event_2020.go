@@ -4,11 +4,8 @@ type BirthdayEvent2020 struct {
 }
 
 func (e BirthdayEvent2020) IsShipEligible(w *Warship) bool {
-	if w.IsTestShip() {
-		return false
-	}
-
-	if w.IsRentalShip() {
+	switch w.Class() {
+	case Test, Rental, ClanRental:
 		return false
 	}
 
@@ -4,11 +4,8 @@ type BirthdayEvent2021 struct {
 }
 
 func (e BirthdayEvent2021) IsShipEligible(w *Warship) bool {
-	if w.IsTestShip() {
-		return false
-	}
-
-	if w.IsRentalShip() {
+	switch w.Class() {
+	case Test, Rental, ClanRental:
 		return false
 	}
 
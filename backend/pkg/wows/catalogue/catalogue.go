@@ -0,0 +1,300 @@
+package catalogue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"rukenshia/frenchwhaling/pkg/storage"
+	"rukenshia/frenchwhaling/pkg/wows"
+	"rukenshia/frenchwhaling/pkg/wows/api"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// snapshotKey is the key of the snapshot in the bucket of the subscriber data, see storage.DefaultLocation
+	snapshotKey = "catalogue/warships.json"
+
+	// DefaultRealm is the realm used to query the encyclopedia when no realm is known
+	DefaultRealm = "eu"
+)
+
+var (
+	// MaxAge is the age after which the stored snapshot is refreshed from the encyclopedia API
+	MaxAge = 24 * time.Hour
+	// ReloadInterval is how often a running process re-reads the stored snapshot
+	ReloadInterval = 15 * time.Minute
+	// MinRefreshInterval limits how often unknown ships can trigger a refresh from the encyclopedia API
+	MinRefreshInterval = 10 * time.Minute
+)
+
+// Default is the catalogue shared by all functions of a process
+var Default = New()
+
+// Catalogue contains all warships known to whaling. It starts out with the snapshot compiled into the
+// binary (wows.Ships) and is updated at runtime from S3 and the encyclopedia API, so that newly released
+// ships are picked up without regenerating the ships and deploying again.
+type Catalogue struct {
+	// Wait is called before every request to the encyclopedia API. The refresh engine sets it to its
+	// limiter, so that refreshes of the catalogue count against the same limit as the refreshes of accounts.
+	Wait api.WaitFunc
+
+	mu        sync.RWMutex
+	ships     map[int64]wows.Warship
+	updatedAt time.Time
+	loadedAt  time.Time
+
+	refreshMu        sync.Mutex
+	lastRefreshStart time.Time
+}
+
+type snapshot struct {
+	UpdatedAt int64
+	Ships     []wows.Warship
+}
+
+// New creates a catalogue that contains the embedded ships
+func New() *Catalogue {
+	ships := make(map[int64]wows.Warship, len(wows.Ships))
+	for id, ship := range wows.Ships {
+		ships[id] = ship
+	}
+
+	return &Catalogue{
+		ships: ships,
+	}
+}
+
+// Get returns the ship with the given ID without trying to refresh the catalogue
+func (c *Catalogue) Get(shipID int64) (wows.Warship, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ship, ok := c.ships[shipID]
+	return ship, ok
+}
+
+// Len returns the number of ships in the catalogue
+func (c *Catalogue) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.ships)
+}
+
+// Lookup returns the ship with the given ID. If the ship is unknown, the catalogue is refreshed from
+// the encyclopedia API of the realm before giving up, as long as there was no refresh in the last
+// MinRefreshInterval.
+func (c *Catalogue) Lookup(ctx context.Context, realm string, shipID int64) (wows.Warship, bool) {
+	if ship, ok := c.Get(shipID); ok {
+		return ship, true
+	}
+
+	log.Printf("Catalogue.Lookup: unknown ship, refreshing shipId=%d realm=%s", shipID, realm)
+	if err := c.refresh(ctx, realm, false); err != nil {
+		log.Printf("Catalogue.Lookup: refresh failed shipId=%d error=%v", shipID, err)
+	}
+
+	return c.Get(shipID)
+}
+
+// EnsureLoaded reloads the stored snapshot if it was not loaded recently. Unlike EnsureFresh it never calls the
+// encyclopedia API, so it can be used by functions that do not have an APPLICATION_ID.
+func (c *Catalogue) EnsureLoaded() error {
+	c.mu.RLock()
+	loadedAt := c.loadedAt
+	c.mu.RUnlock()
+
+	if time.Since(loadedAt) > ReloadInterval {
+		return c.Load()
+	}
+	return nil
+}
+
+// EnsureFresh reloads the stored snapshot if it was not loaded recently and refreshes it from the
+// encyclopedia API once it is older than MaxAge. It is meant to be called at the start of every invocation.
+func (c *Catalogue) EnsureFresh() error {
+	if err := c.EnsureLoaded(); err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	updatedAt := c.updatedAt
+	c.mu.RUnlock()
+
+	if time.Since(updatedAt) > MaxAge {
+		log.Printf("Catalogue.EnsureFresh: snapshot is stale updatedAt=%s", updatedAt)
+		return c.refresh(context.Background(), DefaultRealm, false)
+	}
+	return nil
+}
+
+// Load merges the snapshot stored in S3 into the catalogue. A missing snapshot is not an error,
+// the catalogue keeps using the embedded ships in that case.
+func (c *Catalogue) Load() error {
+	location := storage.DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc := s3manager.NewDownloader(sess)
+
+	buf := &aws.WriteAtBuffer{}
+	if _, err := svc.Download(buf, &s3.GetObjectInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(snapshotKey),
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			log.Printf("Catalogue.Load: no stored snapshot, using embedded ships count=%d", c.Len())
+
+			c.mu.Lock()
+			c.loadedAt = time.Now()
+			c.mu.Unlock()
+			return nil
+		}
+		return err
+	}
+
+	var data snapshot
+	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+		return err
+	}
+
+	c.merge(data.Ships, time.Unix(0, data.UpdatedAt))
+
+	c.mu.Lock()
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+
+	log.Printf("Catalogue.Load: loaded snapshot ships=%d updatedAt=%d", len(data.Ships), data.UpdatedAt)
+	return nil
+}
+
+// Refresh fetches all ships from the encyclopedia API of the realm and stores the result as the new snapshot
+func (c *Catalogue) Refresh(ctx context.Context, realm string) error {
+	return c.refresh(ctx, realm, true)
+}
+
+func (c *Catalogue) refresh(ctx context.Context, realm string, force bool) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	if !force && time.Since(c.lastRefreshStart) < MinRefreshInterval {
+		return nil
+	}
+	c.lastRefreshStart = time.Now()
+
+	entries, err := api.GetEncyclopediaShips(ctx, realm, c.Wait)
+	if err != nil {
+		return err
+	}
+
+	ships := make([]wows.Warship, 0, len(entries))
+	for _, entry := range entries {
+		var ship wows.Warship
+		if err := json.Unmarshal(entry, &ship); err != nil {
+			log.Printf("Catalogue.Refresh: could not parse ship error=%v", err)
+			continue
+		}
+		ship.SpecialGroup = wows.SpecialGroupFromName(ship.Name)
+		ship.LocalizedNames = map[string]string{}
+		ships = append(ships, ship)
+	}
+
+	for _, language := range wows.RealmLanguages[realm] {
+		names, err := api.GetEncyclopediaShipNames(ctx, realm, language, c.Wait)
+		if err != nil {
+			log.Printf("Catalogue.Refresh: could not get localized names language=%s error=%v", language, err)
+			continue
+		}
+
+		for i := range ships {
+			if name, ok := names[ships[i].ShipID]; ok {
+				ships[i].LocalizedNames[language] = name
+			}
+		}
+	}
+
+	now := time.Now()
+	added := c.merge(ships, now)
+	log.Printf("Catalogue.Refresh: refreshed realm=%s ships=%d added=%d", realm, len(ships), added)
+
+	return c.save(now)
+}
+
+// merge adds or replaces the given ships and returns how many ships were not known before
+func (c *Catalogue) merge(ships []wows.Warship, updatedAt time.Time) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	added := 0
+	for _, ship := range ships {
+		known, ok := c.ships[ship.ShipID]
+		if !ok {
+			added++
+		}
+
+		// Names in languages of other realms are kept, a refresh only fetches the languages of one realm
+		for language, name := range known.LocalizedNames {
+			if _, ok := ship.LocalizedNames[language]; !ok {
+				if ship.LocalizedNames == nil {
+					ship.LocalizedNames = map[string]string{}
+				}
+				ship.LocalizedNames[language] = name
+			}
+		}
+		c.ships[ship.ShipID] = ship
+	}
+
+	if updatedAt.After(c.updatedAt) {
+		c.updatedAt = updatedAt
+	}
+	return added
+}
+
+func (c *Catalogue) save(updatedAt time.Time) error {
+	c.mu.RLock()
+	data := snapshot{
+		UpdatedAt: updatedAt.UnixNano(),
+		Ships:     make([]wows.Warship, 0, len(c.ships)),
+	}
+	for _, ship := range c.ships {
+		data.Ships = append(data.Ships, ship)
+	}
+	c.mu.RUnlock()
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	location := storage.DefaultLocation()
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region: aws.String(location.Region),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc := s3manager.NewUploader(sess)
+
+	log.Printf("Catalogue.save: key=%s ships=%d", snapshotKey, len(data.Ships))
+	_, err = svc.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(location.Bucket),
+		Key:    aws.String(snapshotKey),
+		Body:   bytes.NewBuffer(body),
+	})
+	return err
+}
@@ -0,0 +1,92 @@
+package wows
+
+// ShipClass describes how a ship can be obtained, which decides how events treat it
+type ShipClass uint
+
+const (
+	// TechTree ships are researched and bought for credits
+	TechTree ShipClass = iota
+	// Premium ships are bought for doubloons or in the premium shop
+	Premium ShipClass = iota
+	// Special ships are not premium, but can only be obtained through the armory, events or collaborations
+	Special ShipClass = iota
+	// ResearchBureau ships are bought with research points
+	ResearchBureau ShipClass = iota
+	// Rental ships are only available for a limited time, e.g. during rent events
+	Rental ShipClass = iota
+	// ClanRental ships are rented to players for clan battles
+	ClanRental ShipClass = iota
+	// Test ships are still being tested and not available to regular players
+	Test ShipClass = iota
+)
+
+var shipClassNames = map[ShipClass]string{
+	TechTree:       "tech_tree",
+	Premium:        "premium",
+	Special:        "special",
+	ResearchBureau: "research_bureau",
+	Rental:         "rental",
+	ClanRental:     "clan_rental",
+	Test:           "test",
+}
+
+func (c ShipClass) String() string {
+	if name, ok := shipClassNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// groupClasses are the classes of the encyclopedia groups. Ships in other groups, or from exports without
+// the group, are classified by the remaining fields.
+var groupClasses = map[ShipGroup]ShipClass{
+	GroupStart:                TechTree,
+	GroupUpgradeable:          TechTree,
+	GroupUpgradeableUltimate:  TechTree,
+	GroupSuperShip:            TechTree,
+	GroupUpgradeableExclusive: ResearchBureau,
+	GroupPremium:              Premium,
+	GroupSpecial:              Special,
+	GroupSpecialUnsellable:    Special,
+	GroupEarlyAccess:          Special,
+	GroupEvent:                Rental,
+	GroupClan:                 ClanRental,
+	GroupDemoWithoutStats:     Test,
+}
+
+// Classify returns the class of a ship. Entries in ClassOverrides take precedence, then the group of the
+// encyclopedia. Everything else is decided on the remaining fields of the encyclopedia.
+func Classify(w *Warship) ShipClass {
+	if class, ok := ClassOverrides[w.ShipID]; ok {
+		return class
+	}
+
+	if class, ok := groupClasses[w.Group]; ok {
+		return class
+	}
+
+	if w.HasDemoProfile {
+		return Test
+	}
+
+	if w.IsPremium {
+		return Premium
+	}
+
+	if w.IsSpecial {
+		return Special
+	}
+
+	// Every ship in the tech tree either costs credits or leads to another ship (tier 1 ships are free)
+	if w.PriceCredit > 0 || len(w.NextShips) > 0 {
+		return TechTree
+	}
+
+	// Ships without any price can not be bought
+	if w.PriceGold == 0 {
+		return Rental
+	}
+
+	// Ships sold for coal, steel and other resources only list a doubloon equivalent
+	return Special
+}
@@ -0,0 +1,104 @@
+package wows
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClassifyEmbeddedShips(t *testing.T) {
+	for id, ship := range Ships {
+		ship := ship
+		class := Classify(&ship)
+		if class.String() == "unknown" {
+			t.Errorf("%s (%d) has no class", ship.Name, id)
+		}
+		if _, ok := ClassOverrides[id]; ok {
+			continue
+		}
+
+		switch {
+		case ship.HasDemoProfile && class != Test:
+			t.Errorf("%s (%d) has a demo profile, but is %s", ship.Name, id, class)
+		case !ship.HasDemoProfile && ship.IsPremium && class != Premium:
+			t.Errorf("%s (%d) is premium, but is %s", ship.Name, id, class)
+		}
+	}
+
+	for id := range ClassOverrides {
+		if _, ok := Ships[id]; !ok {
+			continue
+		}
+		ship := Ships[id]
+		if strings.HasPrefix(ship.Name, "[") && Classify(&ship) != ClanRental {
+			t.Errorf("%s (%d) is not a clan rental", ship.Name, id)
+		}
+	}
+
+	tests := []struct {
+		shipID int64
+		class  ShipClass
+	}{
+		{3743364816, Premium},        // Ise
+		{3667834576, Premium},        // Atago B
+		{4276041424, TechTree},       // Yamato
+		{4274927600, TechTree},       // Clemson
+		{3655251408, Special},        // Smolensk
+		{3560912592, Special},        // ARP Yamato
+		{3550360880, ResearchBureau}, // Ragnar
+		{3338548944, ClanRental},     // [Shimakaze]
+		{3340679120, Test},           // Cyclops
+	}
+	for _, tt := range tests {
+		ship, ok := Ships[tt.shipID]
+		if !ok {
+			t.Errorf("ship %d is not in the embedded list", tt.shipID)
+			continue
+		}
+		if class := Classify(&ship); class != tt.class {
+			t.Errorf("%s (%d) is %s, want %s", ship.Name, tt.shipID, class, tt.class)
+		}
+	}
+}
+
+func TestClassifyGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		ship  Warship
+		class ShipClass
+	}{
+		{"group wins over the premium flag", Warship{Group: GroupEvent, IsPremium: true, PriceGold: 5000}, Rental},
+		{"research bureau", Warship{Group: GroupUpgradeableExclusive, PriceGold: 34650}, ResearchBureau},
+		{"supership", Warship{Group: GroupSuperShip, Tier: 11}, TechTree},
+		{"special", Warship{Group: GroupSpecial, PriceGold: 34650}, Special},
+		{"clan", Warship{Group: GroupClan}, ClanRental},
+		{"test", Warship{Group: GroupDemoWithoutStats}, Test},
+		{"unknown group", Warship{Group: "somethingNew", PriceCredit: 1000}, TechTree},
+		{"no group", Warship{IsSpecial: true, PriceGold: 34650}, Special},
+		{"override wins over the group", Warship{ShipID: 3550360880, Group: GroupSpecial}, ResearchBureau},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if class := Classify(&tt.ship); class != tt.class {
+				t.Errorf("Classify() = %s, want %s", class, tt.class)
+			}
+		})
+	}
+}
+
+func TestSpecialGroupFromName(t *testing.T) {
+	tests := map[string]SpecialGroup{
+		"ARP Yamato":      ARP,
+		"HSF Hiei":        HSF,
+		"AL Sov. Rossiya": AzurLane,
+		"Atago B":         BVariant,
+		"Atago":           NoGroup,
+		"Bismarck":        NoGroup,
+	}
+
+	for name, expected := range tests {
+		if group := SpecialGroupFromName(name); group != expected {
+			t.Errorf("SpecialGroupFromName(%q) = %q, want %q", name, group, expected)
+		}
+	}
+}
@@ -5,10 +5,33 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"log"
+	"regexp"
 	"rukenshia/frenchwhaling/pkg/wows"
 	"text/template"
 )
 
+// shipIndex matches the index of a ship in the name of its images, e.g. "PJSB526" for a japanese battleship
+var shipIndex = regexp.MustCompile(`/P[A-Z]S([ABCDS])\d+`)
+
+var shipTypes = map[string]wows.ShipType{
+	"A": wows.AirCarrier,
+	"B": wows.Battleship,
+	"C": wows.Cruiser,
+	"D": wows.Destroyer,
+	"S": wows.Submarine,
+}
+
+// typeFromImages returns the type of a ship from the index in its image URLs. Older exports of the
+// encyclopedia do not contain the type.
+func typeFromImages(images wows.Images) wows.ShipType {
+	for _, url := range []string{images.Small, images.Medium, images.Large, images.Contour} {
+		if match := shipIndex.FindStringSubmatch(url); match != nil {
+			return shipTypes[match[1]]
+		}
+	}
+	return ""
+}
+
 func main() {
 	data, err := ioutil.ReadFile("./warships.json")
 	if err != nil {
@@ -20,7 +43,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	warshipTpl, err := template.New("warship").Parse("{{.ShipID}}: Warship{Name:\"{{.Name}}\",PriceGold:{{.PriceGold}},Nation:\"{{.Nation}}\",IsPremium:{{.IsPremium}},ShipID:{{.ShipID}},PriceCredit:{{.PriceCredit}},Tier:{{.Tier}},NextShips:map[string]int64{ {{range $i,$e := .NextShips}}\"{{$i}}\": {{$e}},{{end}} }, HasDemoProfile:{{.HasDemoProfile}}}")
+	warshipTpl, err := template.New("warship").Parse("{{.ShipID}}: Warship{Name:{{printf \"%q\" .Name}},PriceGold:{{.PriceGold}},Nation:\"{{.Nation}}\",IsPremium:{{.IsPremium}},ShipID:{{.ShipID}},PriceCredit:{{.PriceCredit}},Tier:{{.Tier}},NextShips:map[string]int64{ {{range $i,$e := .NextShips}}\"{{$i}}\": {{$e}},{{end}} }, HasDemoProfile:{{.HasDemoProfile}}, IsSpecial:{{.IsSpecial}}, Type:\"{{.Type}}\", Images:Images{Small:\"{{.Images.Small}}\",Medium:\"{{.Images.Medium}}\",Large:\"{{.Images.Large}}\",Contour:\"{{.Images.Contour}}\"}, LocalizedNames:map[string]string{ {{range $i,$e := .LocalizedNames}}\"{{$i}}\": {{printf \"%q\" $e}},{{end}} }, Group:\"{{.Group}}\", SpecialGroup:\"{{.SpecialGroup}}\"}")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -32,6 +55,18 @@ func main() {
 
 	var structs []string
 	for _, w := range warships {
+		w.SpecialGroup = wows.SpecialGroupFromName(w.Name)
+		if w.Type == "" {
+			w.Type = typeFromImages(w.Images)
+		}
+		if w.Type == "" {
+			log.Fatalf("unknown type of ship shipId=%d name=%s", w.ShipID, w.Name)
+		}
+		// Names in other languages are added by the catalogue when it is refreshed from the encyclopedia
+		if len(w.LocalizedNames) == 0 {
+			w.LocalizedNames = map[string]string{"en": w.Name}
+		}
+
 		var buf bytes.Buffer
 		if err := warshipTpl.Execute(&buf, w); err != nil {
 			log.Fatal(err)
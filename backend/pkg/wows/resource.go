@@ -1,28 +1,156 @@
 package wows
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Resource is something that can be earned during an event.
+//
+// The numeric values are stored in DynamoDB and in old public data, they must never be reordered. In JSON, resources
+// are written by their ID and both IDs and the old numbers are read.
 type Resource uint
 
 const (
 	// RepublicTokens are tokens that can be earned during the update 0.8.6
 	RepublicTokens Resource = iota
 	// Coal is a universal resource in the WoWS Armory
-	Coal Resource = iota
+	Coal
 	// Steel is a harder to receive resource, used in the WoWS Armory for ships
-	Steel Resource = iota
+	Steel
 	// SantaGiftContainer is a special container for the Snowflake 2019 event (0.8.11)
-	SantaGiftContainer Resource = iota
+	SantaGiftContainer
 	// SuperContainer is a special container that can usually only be received by chance in
 	// daily containers or through missions/events
-	SuperContainer Resource = iota
+	SuperContainer
 	// AnniversaryCamouflages are a special camouflage
-	AnniversaryCamouflages Resource = iota
+	AnniversaryCamouflages
 	// AnniversaryContainers are a special container for the WoWS Anniversary
-	AnniversaryContainers Resource = iota
+	AnniversaryContainers
 	// FestiveToken is a special resource for the 2021 anniversary event
-	FestiveToken = iota
+	FestiveToken
 	// FestiveTokenAndAnniversaryContainer is a composite of one FestiveToken and an AnniversaryContainer
 	// I did this as a quick hack because the entire code relies on only one resource per redeemable
-	FestiveTokenAndAnniversaryContainer = iota
+	FestiveTokenAndAnniversaryContainer
 	// NewYearCertificate is a special resource first handed out in 2021 (snowflake)
-	NewYearCertificate = iota
+	NewYearCertificate
 )
+
+// ResourceInfo describes a resource
+type ResourceInfo struct {
+	// ID is the stable name of the resource, it is used in JSON and must never change
+	ID   string
+	Name string
+	// Icon is the file name of the icon in the frontend's img/resources directory
+	Icon string
+	// Value ranks how valuable a single unit is compared to the other resources, higher is rarer
+	Value int
+}
+
+// Resources are all known resources in the order they were added
+var Resources = []Resource{
+	RepublicTokens,
+	Coal,
+	Steel,
+	SantaGiftContainer,
+	SuperContainer,
+	AnniversaryCamouflages,
+	AnniversaryContainers,
+	FestiveToken,
+	FestiveTokenAndAnniversaryContainer,
+	NewYearCertificate,
+}
+
+var resourceInfo = map[Resource]ResourceInfo{
+	RepublicTokens:                      {ID: "republic_tokens", Name: "Republic Tokens", Icon: "republic_tokens.png", Value: 1},
+	Coal:                                {ID: "coal", Name: "Coal", Icon: "coal.png", Value: 1},
+	Steel:                               {ID: "steel", Name: "Steel", Icon: "steel.png", Value: 3},
+	SantaGiftContainer:                  {ID: "santa_container", Name: "Santa Container", Icon: "santa_container.png", Value: 2},
+	SuperContainer:                      {ID: "super_container", Name: "Super Container", Icon: "super_container.png", Value: 4},
+	AnniversaryCamouflages:              {ID: "anniversary_camouflage", Name: "Anniversary Camouflage", Icon: "anniversary_camouflage.png", Value: 1},
+	AnniversaryContainers:               {ID: "anniversary_container", Name: "Anniversary Container", Icon: "anniversary_container.png", Value: 2},
+	FestiveToken:                        {ID: "festive_token", Name: "Festive Token", Icon: "festive_token.png", Value: 1},
+	FestiveTokenAndAnniversaryContainer: {ID: "festive_token_and_anniversary_container", Name: "Festive Token and Anniversary Container", Icon: "festive_token_and_anniversary_container.png", Value: 3},
+	NewYearCertificate:                  {ID: "new_year_certificate", Name: "New Year Certificate", Icon: "new_year_certificate.png", Value: 4},
+}
+
+// Info returns the metadata of the resource, unknown resources only have an ID
+func (r Resource) Info() ResourceInfo {
+	if info, ok := resourceInfo[r]; ok {
+		return info
+	}
+	return ResourceInfo{ID: strconv.FormatUint(uint64(r), 10)}
+}
+
+// ID returns the stable name of the resource
+func (r Resource) ID() string {
+	return r.Info().ID
+}
+
+// Name returns the name of the resource as shown to players
+func (r Resource) Name() string {
+	return r.Info().Name
+}
+
+// String returns the ID of the resource
+func (r Resource) String() string {
+	return r.ID()
+}
+
+// ParseResource returns the resource with the given ID. The old numeric values are accepted as well.
+func ParseResource(s string) (Resource, error) {
+	for r, info := range resourceInfo {
+		if info.ID == s {
+			return r, nil
+		}
+	}
+
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		if _, ok := resourceInfo[Resource(n)]; ok {
+			return Resource(n), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown resource %q", s)
+}
+
+// MarshalText writes the resource by its ID, which is also used when resources are map keys
+func (r Resource) MarshalText() ([]byte, error) {
+	if _, ok := resourceInfo[r]; !ok {
+		return nil, fmt.Errorf("unknown resource %d", uint(r))
+	}
+	return []byte(r.ID()), nil
+}
+
+// UnmarshalText reads a resource ID or an old numeric value
+func (r *Resource) UnmarshalText(text []byte) error {
+	parsed, err := ParseResource(string(text))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// MarshalJSON writes the resource as a string
+func (r Resource) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON reads both the ID of the resource and the number it was stored as before
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	var n uint
+	if err := json.Unmarshal(data, &n); err == nil {
+		return r.UnmarshalText([]byte(strconv.FormatUint(uint64(n), 10)))
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("resource must be a string or number: %v", err)
+	}
+	return r.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,28 @@
+package wows
+
+// ClassOverrides contains ships that can not be classified correctly from the encyclopedia alone.
+// Add an entry whenever WG releases a ship that ends up in the wrong class.
+var ClassOverrides = map[int64]ShipClass{
+	// Research bureau ships are listed like ships sold for steel
+	3550360880: ResearchBureau, // Ragnar
+
+	// Superships do not have a credit price and are not listed as next ship of the tier 10
+	4178523952: TechTree, // Hannover
+	4178491376: TechTree, // Annapolis
+	4178523856: TechTree, // Satsuma
+
+	// Clan battle rentals look like any other rental ship
+	3338548944: ClanRental, // [Shimakaze]
+	3340744656: ClanRental, // [Audacious]
+	3332323024: ClanRental, // [Yamato]
+	3340711888: ClanRental, // [Conqueror]
+	3315513040: ClanRental, // [Zaō]
+	3333404368: ClanRental, // [Hakuryū]
+	3340678608: ClanRental, // [Moskva]
+	3340678960: ClanRental, // [Hindenburg]
+	3340711728: ClanRental, // [Grosser Kurfürst]
+	3335501808: ClanRental, // [Midway]
+	3337500656: ClanRental, // [Gearing]
+	3333371888: ClanRental, // [Montana]
+	3340645840: ClanRental, // [Grozovoi]
+}
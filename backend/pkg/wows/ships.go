@@ -4,555 +4,555 @@ package wows
 
 // Ships is an autogenerated export of the WoWS Ships API, stripped down to fields needed for this project.
 var Ships = map[int64]Warship{
-  3743364816: Warship{Name:"Ise",PriceGold:6500,Nation:"japan",IsPremium:true,ShipID:3743364816,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3553572848: Warship{Name:"California",PriceGold:9500,Nation:"usa",IsPremium:true,ShipID:3553572848,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3541972176: Warship{Name:"Ship Smasha",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:3541972176,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741234640: Warship{Name:"Ochakov",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3741234640,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655251408: Warship{Name:"Smolensk",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3655251408,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655218480: Warship{Name:"Småland",PriceGold:34650,Nation:"europe",IsPremium:false,ShipID:3655218480,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3338548944: Warship{Name:"[Shimakaze]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3338548944,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667801808: Warship{Name:"Asashio B",PriceGold:9300,Nation:"japan",IsPremium:true,ShipID:3667801808,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3749623504: Warship{Name:"Yoshino",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3749623504,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3553540080: Warship{Name:"Flint",PriceGold:10000,Nation:"usa",IsPremium:true,ShipID:3553540080,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340744656: Warship{Name:"[Audacious]",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340744656,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3551475152: Warship{Name:"AL Sov. Rossiya",PriceGold:19000,Nation:"ussr",IsPremium:true,ShipID:3551475152,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667834576: Warship{Name:"Atago B",PriceGold:10700,Nation:"japan",IsPremium:true,ShipID:3667834576,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3554555600: Warship{Name:"Shinonome",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:3554555600,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3532568272: Warship{Name:"Eastern Dragon",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3532568272,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3560912592: Warship{Name:"ARP Yamato",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3560912592,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3555670000: Warship{Name:"Texas",PriceGold:5150,Nation:"usa",IsPremium:true,ShipID:3555670000,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667900400: Warship{Name:"Saipan B",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3667900400,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3332323024: Warship{Name:"[Yamato]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3332323024,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340711888: Warship{Name:"[Conqueror]",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340711888,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3315513040: Warship{Name:"[Zaō]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3315513040,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3545183952: Warship{Name:"HSF Hiei",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3545183952,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340679120: Warship{Name:"Cyclops",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340679120,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3740153648: Warship{Name:"ZF-6",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3740153648,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3730749424: Warship{Name:"Rochester",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3730749424,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3666818864: Warship{Name:"Pommern B",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3666818864,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3445503440: Warship{Name:"DD R-10",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3445503440,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3333404368: Warship{Name:"[Hakuryū]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3333404368,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741202128: Warship{Name:"HSF Harekaze II",PriceGold:10000,Nation:"japan",IsPremium:true,ShipID:3741202128,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3551442640: Warship{Name:"ARP Haguro",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3551442640,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3445536752: Warship{Name:"Austin",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3445536752,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3742316496: Warship{Name:"Duke of York",PriceGold:9800,Nation:"uk",IsPremium:true,ShipID:3742316496,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667802064: Warship{Name:"Cossack B",PriceGold:9600,Nation:"uk",IsPremium:true,ShipID:3667802064,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3554588464: Warship{Name:"HSF Admiral Graf Spee",PriceGold:5100,Nation:"germany",IsPremium:true,ShipID:3554588464,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3676223184: Warship{Name:"Yoshino B",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3676223184,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340678608: Warship{Name:"[Moskva]",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3340678608,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3659445712: Warship{Name:"Admiral Makarov",PriceGold:5100,Nation:"ussr",IsPremium:true,ShipID:3659445712,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3552491216: Warship{Name:"ARP Takao",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3552491216,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340711920: Warship{Name:"Connecticut",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3340711920,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3667867440: Warship{Name:"Tirpitz B",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3667867440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3668916016: Warship{Name:"Scharnhorst B",PriceGold:9500,Nation:"germany",IsPremium:true,ShipID:3668916016,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3744380368: Warship{Name:"Kirov",PriceGold:4000,Nation:"ussr",IsPremium:true,ShipID:3744380368,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340645680: Warship{Name:"Lappland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:3340645680,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3541972688: Warship{Name:"AL Yukikaze",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3541972688,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3553539792: Warship{Name:"ARP Ashigara",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3553539792,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3363780304: Warship{Name:"Ragnarok",PriceGold:12500,Nation:"japan",IsPremium:true,ShipID:3363780304,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655317296: Warship{Name:"Max Immelmann",PriceGold:34650,Nation:"germany",IsPremium:false,ShipID:3655317296,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3555636944: Warship{Name:"ARP Myōkō",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3555636944,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655251664: Warship{Name:"Kitakami",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3655251664,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3374266064: Warship{Name:"Ignis Purgatio",PriceGold:12500,Nation:"japan",IsPremium:true,ShipID:3374266064,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3542005552: Warship{Name:"Cross of Dorn",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3542005552,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3658397424: Warship{Name:"Gorizia",PriceGold:8500,Nation:"italy",IsPremium:true,ShipID:3658397424,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741267408: Warship{Name:"Borodino",PriceGold:12500,Nation:"ussr",IsPremium:true,ShipID:3741267408,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3668883440: Warship{Name:"Boise",PriceGold:8500,Nation:"usa",IsPremium:true,ShipID:3668883440,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3522082512: Warship{Name:"ARP Nachi",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3522082512,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3550394352: Warship{Name:"Salem",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3550394352,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3551410160: Warship{Name:"Black",PriceGold:20000,Nation:"usa",IsPremium:true,ShipID:3551410160,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3730748880: Warship{Name:"Pyotr Bagration",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3730748880,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3666818896: Warship{Name:"Jean Bart B",PriceGold:19200,Nation:"france",IsPremium:true,ShipID:3666818896,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667900208: Warship{Name:"Graf Zeppelin B",PriceGold:13700,Nation:"germany",IsPremium:true,ShipID:3667900208,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655251952: Warship{Name:"Puerto Rico",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3655251952,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750639408: Warship{Name:"Z-44",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3750639408,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340678960: Warship{Name:"[Hindenburg]",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3340678960,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3542005744: Warship{Name:"AL Montpelier",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3542005744,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3552523984: Warship{Name:"ARP Hiei",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3552523984,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3530504176: Warship{Name:"Georgia",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3530504176,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3730782192: Warship{Name:"Constellation",PriceGold:12500,Nation:"usa",IsPremium:true,ShipID:3730782192,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3550360880: Warship{Name:"Ragnar",PriceGold:34650,Nation:"europe",IsPremium:false,ShipID:3550360880,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741267792: Warship{Name:"Champagne",PriceGold:12500,Nation:"france",IsPremium:true,ShipID:3741267792,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3749623248: Warship{Name:"STALINGRAD #2",PriceGold:99999,Nation:"ussr",IsPremium:false,ShipID:3749623248,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3340711728: Warship{Name:"[Grosser Kurfürst]",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3340711728,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3542005456: Warship{Name:"ARP Maya",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3542005456,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3553572560: Warship{Name:"ARP Haruna",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3553572560,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3555669712: Warship{Name:"ARP Kongō",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3555669712,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3335501808: Warship{Name:"[Midway]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3335501808,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3669964624: Warship{Name:"Dunkerque B",PriceGold:5200,Nation:"france",IsPremium:true,ShipID:3669964624,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3337500656: Warship{Name:"[Gearing]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3337500656,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3543054032: Warship{Name:"Southern Dragon",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3543054032,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3554621136: Warship{Name:"ARP Kirishima",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3554621136,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3679369200: Warship{Name:"Atlanta B",PriceGold:9000,Nation:"usa",IsPremium:true,ShipID:3679369200,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3668850672: Warship{Name:"Sims B",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:3668850672,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667867632: Warship{Name:"Massachusetts B",PriceGold:12750,Nation:"usa",IsPremium:true,ShipID:3667867632,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655251920: Warship{Name:"Gibraltar",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3655251920,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741235184: Warship{Name:"Congress",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3741235184,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3333371888: Warship{Name:"[Montana]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3333371888,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3667801296: Warship{Name:"Loyang B",PriceGold:8000,Nation:"pan_asia",IsPremium:true,ShipID:3667801296,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3655284688: Warship{Name:"Incomparable",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3655284688,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3667900112: Warship{Name:"Kaga B",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3667900112,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3720263120: Warship{Name:"Bagration",PriceGold:11000,Nation:"ussr",IsPremium:false,ShipID:3720263120,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3741267952: Warship{Name:"Alabama VL",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3741267952,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3552524016: Warship{Name:"AL Littorio",PriceGold:12500,Nation:"italy",IsPremium:true,ShipID:3552524016,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3743365072: Warship{Name:"Repulse",PriceGold:6500,Nation:"uk",IsPremium:false,ShipID:3743365072,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3730782032: Warship{Name:"Flandre",PriceGold:12500,Nation:"france",IsPremium:true,ShipID:3730782032,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3552524272: Warship{Name:"Alabama ST",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3552524272,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3666786288: Warship{Name:"Alaska B",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3666786288,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741235152: Warship{Name:"Belfast '43",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3741235152,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3741300720: Warship{Name:"Saipan",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3741300720,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3551409616: Warship{Name:"Neustrashimy",PriceGold:19000,Nation:"ussr",IsPremium:true,ShipID:3551409616,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3340645840: Warship{Name:"[Grozovoi]",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3340645840,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3552458448: Warship{Name:"HSF Harekaze",PriceGold:9700,Nation:"japan",IsPremium:true,ShipID:3552458448,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763287760: Warship{Name:"Ashitaka",PriceGold:9200,Nation:"japan",IsPremium:true,ShipID:3763287760,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761190096: Warship{Name:"Bajie",PriceGold:19000,Nation:"pan_asia",IsPremium:true,ShipID:3761190096,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750704336: Warship{Name:"Wujing",PriceGold:19000,Nation:"pan_asia",IsPremium:true,ShipID:3750704336,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762205904: Warship{Name:"Irian",PriceGold:11500,Nation:"pan_asia",IsPremium:true,ShipID:3762205904,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109008: Warship{Name:"Stalingrad",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3760109008,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763255280: Warship{Name:"Indianapolis",PriceGold:7500,Nation:"usa",IsPremium:true,ShipID:3763255280,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751720400: Warship{Name:"Lazo",PriceGold:8000,Nation:"ussr",IsPremium:true,ShipID:3751720400,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762173232: Warship{Name:"Orkan",PriceGold:10000,Nation:"europe",IsPremium:true,ShipID:3762173232,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760076496: Warship{Name:"Hayate",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3760076496,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763222224: Warship{Name:"Yūdachi",PriceGold:6500,Nation:"japan",IsPremium:true,ShipID:3763222224,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761190608: Warship{Name:"Musashi",PriceGold:19200,Nation:"japan",IsPremium:true,ShipID:3761190608,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763255248: Warship{Name:"Belfast",PriceGold:8200,Nation:"uk",IsPremium:true,ShipID:3763255248,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109520: Warship{Name:"Plymouth",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760109520,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762271440: Warship{Name:"Sanzang",PriceGold:11000,Nation:"pan_asia",IsPremium:true,ShipID:3762271440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760174896: Warship{Name:"Werner Voss",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3760174896,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3751753168: Warship{Name:"Lenin",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3751753168,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3756963280: Warship{Name:"Varyag",PriceGold:2200,Nation:"ussr",IsPremium:true,ShipID:3756963280,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109264: Warship{Name:"Azuma",PriceGold:0,Nation:"japan",IsPremium:true,ShipID:3760109264,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239184: Warship{Name:"Kii",PriceGold:12900,Nation:"japan",IsPremium:true,ShipID:3762239184,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3752736720: Warship{Name:"Cossack",PriceGold:9600,Nation:"uk",IsPremium:true,ShipID:3752736720,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762271696: Warship{Name:"Chkalov",PriceGold:12000,Nation:"ussr",IsPremium:true,ShipID:3762271696,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3752802000: Warship{Name:"Hyūga",PriceGold:9500,Nation:"japan",IsPremium:true,ShipID:3752802000,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750704848: Warship{Name:"Hizen",PriceGold:19000,Nation:"japan",IsPremium:true,ShipID:3750704848,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761125136: Warship{Name:"Groningen",PriceGold:0,Nation:"netherlands",IsPremium:true,ShipID:3761125136,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3753818064: Warship{Name:"London",PriceGold:5500,Nation:"uk",IsPremium:true,ShipID:3753818064,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206672: Warship{Name:"Cheshire",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3762206672,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3753785136: Warship{Name:"Karl von Schönberg",PriceGold:5000,Nation:"germany",IsPremium:true,ShipID:3753785136,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751786448: Warship{Name:"Ark Royal",PriceGold:6000,Nation:"uk",IsPremium:true,ShipID:3751786448,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3753850320: Warship{Name:"Novorossiysk",PriceGold:6500,Nation:"ussr",IsPremium:true,ShipID:3753850320,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751786192: Warship{Name:"Kaga",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3751786192,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763288016: Warship{Name:"Hood",PriceGold:9700,Nation:"uk",IsPremium:true,ShipID:3763288016,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761157936: Warship{Name:"Siegfried",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3761157936,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762173744: Warship{Name:"Z-39",PriceGold:5900,Nation:"germany",IsPremium:true,ShipID:3762173744,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760076144: Warship{Name:"Vampire II",PriceGold:34650,Nation:"commonwealth",IsPremium:false,ShipID:3760076144,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762272208: Warship{Name:"Indomitable",PriceGold:12000,Nation:"uk",IsPremium:true,ShipID:3762272208,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761190704: Warship{Name:"Pommern",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3761190704,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761157584: Warship{Name:"Kronshtadt",PriceGold:19200,Nation:"ussr",IsPremium:true,ShipID:3761157584,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750704880: Warship{Name:"Giuseppe Verdi",PriceGold:19000,Nation:"italy",IsPremium:false,ShipID:3750704880,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3761190896: Warship{Name:"Missouri",PriceGold:19200,Nation:"usa",IsPremium:true,ShipID:3761190896,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751786288: Warship{Name:"Graf Zeppelin",PriceGold:13700,Nation:"germany",IsPremium:true,ShipID:3751786288,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760142032: Warship{Name:"Shikishima",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3760142032,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3753817456: Warship{Name:"Mysore",PriceGold:5500,Nation:"commonwealth",IsPremium:true,ShipID:3753817456,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206160: Warship{Name:"Mikhail Kutuzov",PriceGold:10250,Nation:"ussr",IsPremium:true,ShipID:3762206160,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761125104: Warship{Name:"Paolo Emilio",PriceGold:19000,Nation:"italy",IsPremium:true,ShipID:3761125104,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760076752: Warship{Name:"Druid",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760076752,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239472: Warship{Name:"Alabama",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3762239472,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761157968: Warship{Name:"Carnot",PriceGold:19000,Nation:"france",IsPremium:true,ShipID:3761157968,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761190864: Warship{Name:"Marlborough",PriceGold:19000,Nation:"uk",IsPremium:false,ShipID:3761190864,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3761190640: Warship{Name:"Marco Polo",PriceGold:19000,Nation:"italy",IsPremium:true,ShipID:3761190640,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763254608: Warship{Name:"Nueve de Julio",PriceGold:8500,Nation:"pan_america",IsPremium:true,ShipID:3763254608,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109296: Warship{Name:"Napoli",PriceGold:34650,Nation:"italy",IsPremium:false,ShipID:3760109296,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763288048: Warship{Name:"W. Virginia 1941",PriceGold:7000,Nation:"usa",IsPremium:true,ShipID:3763288048,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762173936: Warship{Name:"Kidd",PriceGold:9100,Nation:"usa",IsPremium:true,ShipID:3762173936,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206480: Warship{Name:"De Zeven Provinciën",PriceGold:11000,Nation:"netherlands",IsPremium:true,ShipID:3762206480,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239216: Warship{Name:"Roma",PriceGold:13100,Nation:"italy",IsPremium:true,ShipID:3762239216,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762173776: Warship{Name:"Le Terrible",PriceGold:9500,Nation:"france",IsPremium:true,ShipID:3762173776,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3752769328: Warship{Name:"Weimar",PriceGold:8500,Nation:"germany",IsPremium:true,ShipID:3752769328,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751720944: Warship{Name:"Anchorage",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3751720944,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751720912: Warship{Name:"Tiger '59",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3751720912,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206512: Warship{Name:"Prinz Eugen",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3762206512,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760141776: Warship{Name:"Slava",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3760141776,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760175088: Warship{Name:"Franklin D. Roosevelt",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760175088,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760142320: Warship{Name:"Ohio",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760142320,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206704: Warship{Name:"Wichita",PriceGold:11500,Nation:"usa",IsPremium:true,ShipID:3762206704,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751687984: Warship{Name:"Z-35",PriceGold:10000,Nation:"germany",IsPremium:true,ShipID:3751687984,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763255088: Warship{Name:"München",PriceGold:8500,Nation:"germany",IsPremium:true,ShipID:3763255088,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109392: Warship{Name:"Colbert",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:3760109392,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760076080: Warship{Name:"Friesland",PriceGold:19000,Nation:"europe",IsPremium:true,ShipID:3760076080,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762206544: Warship{Name:"Bayard",PriceGold:11000,Nation:"france",IsPremium:true,ShipID:3762206544,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751753520: Warship{Name:"Brandenburg",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3751753520,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760076784: Warship{Name:"Somers",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760076784,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760142160: Warship{Name:"Bourgogne",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:3760142160,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751786480: Warship{Name:"Enterprise",PriceGold:14000,Nation:"usa",IsPremium:true,ShipID:3751786480,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751753552: Warship{Name:"Jean Bart",PriceGold:19200,Nation:"france",IsPremium:true,ShipID:3751753552,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762173136: Warship{Name:"Loyang",PriceGold:8000,Nation:"pan_asia",IsPremium:true,ShipID:3762173136,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763221872: Warship{Name:"Haida",PriceGold:5600,Nation:"commonwealth",IsPremium:true,ShipID:3763221872,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762238928: Warship{Name:"Poltava",PriceGold:9500,Nation:"ussr",IsPremium:true,ShipID:3762238928,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761125360: Warship{Name:"Benham",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3761125360,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760109552: Warship{Name:"Alaska",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3760109552,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751753712: Warship{Name:"Massachusetts",PriceGold:12750,Nation:"usa",IsPremium:true,ShipID:3751753712,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239312: Warship{Name:"Gascogne",PriceGold:12400,Nation:"france",IsPremium:true,ShipID:3762239312,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3761157328: Warship{Name:"Dalian",PriceGold:19000,Nation:"pan_asia",IsPremium:false,ShipID:3761157328,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3751687888: Warship{Name:"Asashio",PriceGold:9300,Nation:"japan",IsPremium:true,ShipID:3751687888,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3752802288: Warship{Name:"Florida",PriceGold:9500,Nation:"usa",IsPremium:true,ShipID:3752802288,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751687376: Warship{Name:"Fenyang",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:3751687376,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750672176: Warship{Name:"Ägir",PriceGold:0,Nation:"germany",IsPremium:true,ShipID:3750672176,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239280: Warship{Name:"Odin",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3762239280,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3762239440: Warship{Name:"Vanguard",PriceGold:13000,Nation:"uk",IsPremium:true,ShipID:3762239440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763255024: Warship{Name:"Duca degli Abruzzi",PriceGold:8600,Nation:"italy",IsPremium:true,ShipID:3763255024,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763287888: Warship{Name:"Strasbourg",PriceGold:9500,Nation:"france",IsPremium:true,ShipID:3763287888,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763287408: Warship{Name:"Yukon",PriceGold:9500,Nation:"commonwealth",IsPremium:true,ShipID:3763287408,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750672368: Warship{Name:"Tulsa",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3750672368,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3752802256: Warship{Name:"Nelson",PriceGold:9500,Nation:"uk",IsPremium:true,ShipID:3752802256,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751720144: Warship{Name:"Wukong",PriceGold:11000,Nation:"pan_asia",IsPremium:true,ShipID:3751720144,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3751720752: Warship{Name:"Mainz",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3751720752,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763287856: Warship{Name:"Scharnhorst",PriceGold:9500,Nation:"germany",IsPremium:true,ShipID:3763287856,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3760142288: Warship{Name:"Thunderer",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760142288,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3750705136: Warship{Name:"Kearsarge",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3750705136,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3763221968: Warship{Name:"Leningrad",PriceGold:5250,Nation:"ussr",IsPremium:true,ShipID:3763221968,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3754866128: Warship{Name:"Mikoyan",PriceGold:4000,Nation:"ussr",IsPremium:true,ShipID:3754866128,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4078876656: Warship{Name:"Dallas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4078876656,PriceCredit:3100000,Tier:6,NextShips:map[string]int64{ "4077828080": 75000, }, HasDemoProfile:false},
-  3764271056: Warship{Name:"Gallant",PriceGold:4500,Nation:"uk",IsPremium:true,ShipID:3764271056,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4076746544: Warship{Name:"Gustav-Julius Maerker",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4076746544,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4075697968": 165000, }, HasDemoProfile:false},
-  3764303056: Warship{Name:"Huanghe",PriceGold:5400,Nation:"pan_asia",IsPremium:true,ShipID:3764303056,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4075730384: Warship{Name:"Riga",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4075730384,PriceCredit:13800000,Tier:9,NextShips:map[string]int64{ "3969824208": 240000, }, HasDemoProfile:false},
-  4076778960: Warship{Name:"Tallinn",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4076778960,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4075730384": 165000, }, HasDemoProfile:false},
-  4079891920: Warship{Name:"Podvoisky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4079891920,PriceCredit:1400000,Tier:5,NextShips:map[string]int64{ "4078843344": 34500, }, HasDemoProfile:false},
-  4074681808: Warship{Name:"Alexander Nevsky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4074681808,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4179506480: Warship{Name:"Halland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4179506480,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4077795024: Warship{Name:"Shiratsuyu",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4077795024,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181604048": 115000, }, HasDemoProfile:false},
-  4065212112: Warship{Name:"Kitakaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4065212112,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4074649296": 223000, }, HasDemoProfile:false},
-  4179507024: Warship{Name:"Kléber",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179507024,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074747856: Warship{Name:"Audacious",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4074747856,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4078876624: Warship{Name:"Devonshire",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4078876624,PriceCredit:3300000,Tier:6,NextShips:map[string]int64{ "4077828048": 72000, }, HasDemoProfile:false},
-  4077795120: Warship{Name:"Z-31",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4077795120,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4076746544": 110000, }, HasDemoProfile:false},
-  3765384656: Warship{Name:"Oktyabrskaya Revolutsiya",PriceGold:5050,Nation:"ussr",IsPremium:true,ShipID:3765384656,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3765352144: Warship{Name:"Yahagi",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:3765352144,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074649296: Warship{Name:"Harugumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4074649296,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3767449296: Warship{Name:"Katori",PriceGold:2250,Nation:"japan",IsPremium:true,ShipID:3767449296,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764336432: Warship{Name:"Prinz Eitel Friedrich",PriceGold:6800,Nation:"germany",IsPremium:true,ShipID:3764336432,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4079925200: Warship{Name:"Hawkins",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4079925200,PriceCredit:1400000,Tier:5,NextShips:map[string]int64{ "4078876624": 35000, }, HasDemoProfile:false},
-  4179506992: Warship{Name:"Z-52",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179506992,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3766433072: Warship{Name:"Viribus Unitis",PriceGold:5000,Nation:"europe",IsPremium:true,ShipID:3766433072,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764336336: Warship{Name:"Mutsu",PriceGold:6550,Nation:"japan",IsPremium:true,ShipID:3764336336,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3768465392: Warship{Name:"Smith",PriceGold:1050,Nation:"usa",IsPremium:true,ShipID:3768465392,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4077828048: Warship{Name:"Surrey",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4077828048,PriceCredit:5650000,Tier:7,NextShips:map[string]int64{ "4076779472": 112000, }, HasDemoProfile:false},
-  4077794768: Warship{Name:"Minsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4077794768,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "3971888592": 109000,"4076746192": 105000, }, HasDemoProfile:false},
-  3767416784: Warship{Name:"Campbeltown",PriceGold:1650,Nation:"uk",IsPremium:true,ShipID:3767416784,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074649392: Warship{Name:"Elbing",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4074649392,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3864933840: Warship{Name:"Delny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3864933840,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4076746448: Warship{Name:"Kagerō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4076746448,PriceCredit:9100000,Tier:8,NextShips:map[string]int64{ "4075697872": 154000, }, HasDemoProfile:false},
-  4069438928: Warship{Name:"Kotovsky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4069438928,PriceCredit:1280000,Tier:5,NextShips:map[string]int64{ "4183733712": 34000, }, HasDemoProfile:false},
-  3765385168: Warship{Name:"Agincourt",PriceGold:5000,Nation:"uk",IsPremium:true,ShipID:3765385168,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3768497968: Warship{Name:"Emden",PriceGold:1500,Nation:"germany",IsPremium:true,ShipID:3768497968,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3865982416: Warship{Name:"Tashkent",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3865982416,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4179506640": 226000, }, HasDemoProfile:false},
-  4178458320: Warship{Name:"Yamagiri",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4178458320,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764303216: Warship{Name:"Perth",PriceGold:4800,Nation:"commonwealth",IsPremium:true,ShipID:3764303216,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4081039312: Warship{Name:"Hermes",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4081039312,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799760": 40000, }, HasDemoProfile:false},
-  4178491216: Warship{Name:"Condé",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4178491216,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3767482160: Warship{Name:"König Albert",PriceGold:2200,Nation:"germany",IsPremium:true,ShipID:3767482160,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4178523952: Warship{Name:"Hannover",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4178523952,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4078843600: Warship{Name:"Hatsuharu",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4078843600,PriceCredit:2950000,Tier:6,NextShips:map[string]int64{ "4077795024": 60000, }, HasDemoProfile:false},
-  3765352400: Warship{Name:"Exeter",PriceGold:4000,Nation:"uk",IsPremium:true,ShipID:3765352400,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764369200: Warship{Name:"Erich Loewenhardt",PriceGold:6000,Nation:"germany",IsPremium:true,ShipID:3764369200,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764270896: Warship{Name:"T-61",PriceGold:5400,Nation:"germany",IsPremium:true,ShipID:3764270896,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4075697872: Warship{Name:"Yūgumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4075697872,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4282267344": 223000, }, HasDemoProfile:false},
-  3767482320: Warship{Name:"Dreadnought",PriceGold:3500,Nation:"uk",IsPremium:true,ShipID:3767482320,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4076745936: Warship{Name:"Siliwangi",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:4076745936,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764303600: Warship{Name:"Duca d'Aosta",PriceGold:4700,Nation:"italy",IsPremium:true,ShipID:3764303600,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4178491376: Warship{Name:"Annapolis",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4178491376,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3765352176: Warship{Name:"Genova",PriceGold:4000,Nation:"italy",IsPremium:true,ShipID:3765352176,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764271088: Warship{Name:"Monaghan",PriceGold:4600,Nation:"usa",IsPremium:true,ShipID:3764271088,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074714928: Warship{Name:"Schlieffen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4074714928,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3768497488: Warship{Name:"Almirante Abreu",PriceGold:1550,Nation:"pan_america",IsPremium:true,ShipID:3768497488,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764303312: Warship{Name:"Molotov",PriceGold:4550,Nation:"ussr",IsPremium:true,ShipID:3764303312,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3765384944: Warship{Name:"Giulio Cesare",PriceGold:5200,Nation:"italy",IsPremium:true,ShipID:3765384944,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764270928: Warship{Name:"Aigle",PriceGold:4650,Nation:"france",IsPremium:true,ShipID:3764270928,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764303664: Warship{Name:"Admiral Graf Spee",PriceGold:4700,Nation:"germany",IsPremium:true,ShipID:3764303664,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764336624: Warship{Name:"Arizona",PriceGold:6600,Nation:"usa",IsPremium:true,ShipID:3764336624,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074682352: Warship{Name:"Worcester",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4074682352,PriceCredit:19900000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3766368080: Warship{Name:"Siroco",PriceGold:3500,Nation:"france",IsPremium:true,ShipID:3766368080,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3969857328: Warship{Name:"Preussen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3969857328,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  3971888592: Warship{Name:"Kiev",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3971888592,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "3865982416": 160000, }, HasDemoProfile:false},
-  3765351888: Warship{Name:"Krasny Krym",PriceGold:2950,Nation:"ussr",IsPremium:true,ShipID:3765351888,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3765385200: Warship{Name:"Oklahoma",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:3765385200,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4178523856: Warship{Name:"Satsuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4178523856,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4076812080: Warship{Name:"Zieten",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4076812080,PriceCredit:1,Tier:8,NextShips:map[string]int64{ "4075763504": 0, }, HasDemoProfile:false},
-  3764303696: Warship{Name:"De Grasse",PriceGold:5500,Nation:"france",IsPremium:true,ShipID:3764303696,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3769513264: Warship{Name:"Błyskawica",PriceGold:4800,Nation:"europe",IsPremium:true,ShipID:3769513264,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764369232: Warship{Name:"Béarn",PriceGold:6000,Nation:"france",IsPremium:true,ShipID:3764369232,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4075730928: Warship{Name:"Seattle",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4075730928,PriceCredit:14100000,Tier:9,NextShips:map[string]int64{ "4074682352": 242000, }, HasDemoProfile:false},
-  4075763504: Warship{Name:"Prinz Rupprecht",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4075763504,PriceCredit:0,Tier:9,NextShips:map[string]int64{ "4074714928": 1, }, HasDemoProfile:false},
-  4179506640: Warship{Name:"Khabarovsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179506640,PriceCredit:18800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4077860656: Warship{Name:"Prinz Heinrich",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4077860656,PriceCredit:0,Tier:7,NextShips:map[string]int64{ "4076812080": 1, }, HasDemoProfile:false},
-  3764336464: Warship{Name:"Dunkerque",PriceGold:5200,Nation:"france",IsPremium:true,ShipID:3764336464,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3767416176: Warship{Name:"Vampire",PriceGold:2300,Nation:"commonwealth",IsPremium:true,ShipID:3767416176,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3767449040: Warship{Name:"Oleg",PriceGold:2000,Nation:"ussr",IsPremium:true,ShipID:3767449040,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4078909232: Warship{Name:"Mackensen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4078909232,PriceCredit:1,Tier:6,NextShips:map[string]int64{ "4077860656": 0, }, HasDemoProfile:false},
-  3969824208: Warship{Name:"Petropavlovsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3969824208,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4077828080: Warship{Name:"Helena",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4077828080,PriceCredit:5800000,Tier:7,NextShips:map[string]int64{ "4076779504": 110000, }, HasDemoProfile:false},
-  4074649424: Warship{Name:"Marceau",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:4074649424,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4074682320: Warship{Name:"Goliath",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4074682320,PriceCredit:20500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4079957808: Warship{Name:"Derfflinger",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4079957808,PriceCredit:0,Tier:5,NextShips:map[string]int64{ "4078909232": 1, }, HasDemoProfile:false},
-  3764270288: Warship{Name:"Anshan",PriceGold:3700,Nation:"pan_asia",IsPremium:true,ShipID:3764270288,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4076746192: Warship{Name:"Ognevoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4076746192,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4182652368": 160000, }, HasDemoProfile:false},
-  4076779504: Warship{Name:"Cleveland",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4076779504,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4075730928": 169000, }, HasDemoProfile:false},
-  4075730896: Warship{Name:"Drake",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4075730896,PriceCredit:13800000,Tier:9,NextShips:map[string]int64{ "4074682320": 244000, }, HasDemoProfile:false},
-  4179506384: Warship{Name:"Yueyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4179506384,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4081006384: Warship{Name:"Moltke",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4081006384,PriceCredit:1,Tier:4,NextShips:map[string]int64{ "4079957808": 0, }, HasDemoProfile:false},
-  4074649040: Warship{Name:"Grozovoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4074649040,PriceCredit:18000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4178458064: Warship{Name:"Zorkiy",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4178458064,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764270832: Warship{Name:"Leone",PriceGold:5000,Nation:"italy",IsPremium:true,ShipID:3764270832,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3764270416: Warship{Name:"Juruá",PriceGold:5000,Nation:"pan_america",IsPremium:true,ShipID:3764270416,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3767449584: Warship{Name:"Charleston",PriceGold:2000,Nation:"usa",IsPremium:true,ShipID:3767449584,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4076779472: Warship{Name:"Albemarle",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4076779472,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4075730896": 176000, }, HasDemoProfile:false},
-  4075697968: Warship{Name:"Felix Schultz",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4075697968,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4074649392": 240000, }, HasDemoProfile:false},
-  4082054960: Warship{Name:"Von der Tann",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4082054960,PriceCredit:0,Tier:3,NextShips:map[string]int64{ "4081006384": 1, }, HasDemoProfile:false},
-  3765319664: Warship{Name:"Hill",PriceGold:3500,Nation:"usa",IsPremium:true,ShipID:3765319664,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  3765319120: Warship{Name:"Okhotnik",PriceGold:2700,Nation:"ussr",IsPremium:true,ShipID:3765319120,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4078843344: Warship{Name:"Gnevny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4078843344,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4077794768": 68000, }, HasDemoProfile:false},
-  4183733456: Warship{Name:"Rahmat",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4183733456,PriceCredit:1,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4181636304: Warship{Name:"Harbin",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4181636304,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4183733712: Warship{Name:"Budyonny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183733712,PriceCredit:2850000,Tier:6,NextShips:map[string]int64{ "4182685136": 69500, }, HasDemoProfile:false},
-  4179539408: Warship{Name:"Moskva",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:4179539408,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4181702096: Warship{Name:"Pobeda",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181702096,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179604944": 355000, }, HasDemoProfile:false},
-  4182652208: Warship{Name:"Skåne",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4182652208,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "4181603632": 110000, }, HasDemoProfile:false},
-  4182652624: Warship{Name:"Akatsuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4182652624,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4076746448": 115000, }, HasDemoProfile:false},
-  4181604048: Warship{Name:"Akizuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4181604048,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4065212112": 154000, }, HasDemoProfile:false},
-  4180554960: Warship{Name:"Chung Mu",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4180554960,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179506384": 240000, }, HasDemoProfile:false},
-  4180588528: Warship{Name:"Buffalo",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4180588528,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4273911792": 237500, }, HasDemoProfile:false},
-  4183701456: Warship{Name:"Icarus",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183701456,PriceCredit:3200000,Tier:6,NextShips:map[string]int64{ "4182652880": 65000, }, HasDemoProfile:false},
-  4179539920: Warship{Name:"Minotaur",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179539920,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4179572176: Warship{Name:"Kremlin",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179572176,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4182718192: Warship{Name:"Francesco Caracciolo",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4182718192,PriceCredit:6500000,Tier:7,NextShips:map[string]int64{ "4181669616": 140000, }, HasDemoProfile:false},
-  4181637104: Warship{Name:"Baltimore",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181637104,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180588528": 165000, }, HasDemoProfile:false},
-  4180555728: Warship{Name:"Jutland",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180555728,PriceCredit:13250000,Tier:9,NextShips:map[string]int64{ "4179507152": 240000, }, HasDemoProfile:false},
-  4180555600: Warship{Name:"Mogador",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180555600,PriceCredit:13250000,Tier:9,NextShips:map[string]int64{ "4179507024": 240000, }, HasDemoProfile:false},
-  4180588496: Warship{Name:"Neptune",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180588496,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4179539920": 238000, }, HasDemoProfile:false},
-  4180588272: Warship{Name:"Brindisi",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4180588272,PriceCredit:14000000,Tier:9,NextShips:map[string]int64{ "4179539696": 238000, }, HasDemoProfile:false},
-  4179604944: Warship{Name:"Admiral Nakhimov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179604944,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4182685456: Warship{Name:"Eendracht",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4182685456,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4181636880": 110000, }, HasDemoProfile:false},
-  4182685648: Warship{Name:"Fiji",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182685648,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "4181637072": 108000, }, HasDemoProfile:false},
-  4181604176: Warship{Name:"Le Fantasque",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181604176,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180555600": 166000, }, HasDemoProfile:false},
-  4181637072: Warship{Name:"Edinburgh",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181637072,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180588496": 173000, }, HasDemoProfile:false},
-  4183701296: Warship{Name:"Ernst Gaede",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183701296,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4077795120": 69000,"4182652720": 64000, }, HasDemoProfile:false},
-  4180555568: Warship{Name:"Z-46",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180555568,PriceCredit:12200000,Tier:9,NextShips:map[string]int64{ "4179506992": 240000, }, HasDemoProfile:false},
-  4181702448: Warship{Name:"August von Parseval",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181702448,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605296": 355000, }, HasDemoProfile:false},
-  4179605200: Warship{Name:"Hakuryū",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4179605200,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4183734256: Warship{Name:"Pensacola",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4183734256,PriceCredit:2800000,Tier:6,NextShips:map[string]int64{ "4182685680": 70000, }, HasDemoProfile:false},
-  4181669840: Warship{Name:"Monarch",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181669840,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621264": 180000, }, HasDemoProfile:false},
-  4182685488: Warship{Name:"Yorck",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182685488,PriceCredit:5650000,Tier:7,NextShips:map[string]int64{ "4181636912": 114000, }, HasDemoProfile:false},
-  4181702608: Warship{Name:"Implacable",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181702608,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4074747856": 355000, }, HasDemoProfile:false},
-  4183734224: Warship{Name:"Leander",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183734224,PriceCredit:3040000,Tier:6,NextShips:map[string]int64{ "4182685648": 70000, }, HasDemoProfile:false},
-  4180621104: Warship{Name:"Friedrich der Grosse",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180621104,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572528": 255000, }, HasDemoProfile:false},
-  4182652720: Warship{Name:"Leberecht Maass",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182652720,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181604144": 109000, }, HasDemoProfile:false},
-  4180587984: Warship{Name:"Dmitri Donskoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4180587984,PriceCredit:13750000,Tier:9,NextShips:map[string]int64{ "4074681808": 240000, }, HasDemoProfile:false},
-  4179539792: Warship{Name:"Henri IV",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179539792,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4179605296: Warship{Name:"Manfred von Richthofen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179605296,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4183700688: Warship{Name:"Fushun",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4183700688,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4182652112": 64000, }, HasDemoProfile:false},
-  4181636560: Warship{Name:"Chapayev",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181636560,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180587984": 167000, }, HasDemoProfile:false},
-  4180555056: Warship{Name:"Östergötland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4180555056,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179506480": 235000, }, HasDemoProfile:false},
-  4182684880: Warship{Name:"Chumphon",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4182684880,PriceCredit:1,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4181702352: Warship{Name:"Shōkaku",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4181702352,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605200": 355000, }, HasDemoProfile:false},
-  4180588368: Warship{Name:"Saint-Louis",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180588368,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4179539792": 238000, }, HasDemoProfile:false},
-  4180621264: Warship{Name:"Lion",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180621264,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572688": 255000, }, HasDemoProfile:false},
-  4179539760: Warship{Name:"Hindenburg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179539760,PriceCredit:19600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4179572464: Warship{Name:"Cristoforo Colombo",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4179572464,PriceCredit:20700000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4181636848: Warship{Name:"Amalfi",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4181636848,PriceCredit:9100000,Tier:8,NextShips:map[string]int64{ "4180588272": 160000, }, HasDemoProfile:false},
-  4182685136: Warship{Name:"Shchors",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182685136,PriceCredit:5600000,Tier:7,NextShips:map[string]int64{ "4076778960": 110000,"4181636560": 112000, }, HasDemoProfile:false},
-  4179539696: Warship{Name:"Venezia",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4179539696,PriceCredit:19900000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4181636944: Warship{Name:"Charles Martel",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181636944,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180588368": 165000, }, HasDemoProfile:false},
-  4180588336: Warship{Name:"Roon",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180588336,PriceCredit:13700000,Tier:9,NextShips:map[string]int64{ "4179539760": 238000, }, HasDemoProfile:false},
-  4183766864: Warship{Name:"Normandie",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183766864,PriceCredit:3470000,Tier:6,NextShips:map[string]int64{ "4182718288": 72500, }, HasDemoProfile:false},
-  4179539728: Warship{Name:"Gouden Leeuw",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4179539728,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4181669616: Warship{Name:"Vittorio Veneto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4181669616,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621040": 180000, }, HasDemoProfile:false},
-  4182685520: Warship{Name:"Algérie",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182685520,PriceCredit:5600000,Tier:7,NextShips:map[string]int64{ "4181636944": 110000, }, HasDemoProfile:false},
-  4181604144: Warship{Name:"Z-23",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181604144,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180555568": 150000, }, HasDemoProfile:false},
-  4182685680: Warship{Name:"New Orleans",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4182685680,PriceCredit:5570000,Tier:7,NextShips:map[string]int64{ "4181637104": 110000, }, HasDemoProfile:false},
-  4182718416: Warship{Name:"King George V",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182718416,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669840": 135000, }, HasDemoProfile:false},
-  4183701328: Warship{Name:"Guépard",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183701328,PriceCredit:3200000,Tier:6,NextShips:map[string]int64{ "4182652752": 64000, }, HasDemoProfile:false},
-  4181636912: Warship{Name:"Admiral Hipper",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181636912,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180588336": 168000, }, HasDemoProfile:false},
-  4183700784: Warship{Name:"Västerås",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4183700784,PriceCredit:3000000,Tier:6,NextShips:map[string]int64{ "4182652208": 65000, }, HasDemoProfile:false},
-  4183701200: Warship{Name:"Fubuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4183701200,PriceCredit:2950000,Tier:6,NextShips:map[string]int64{ "4182652624": 63000, }, HasDemoProfile:false},
-  4179572720: Warship{Name:"Vermont",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4179572720,PriceCredit:20700000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4183734096: Warship{Name:"La Galissonnière",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183734096,PriceCredit:2850000,Tier:6,NextShips:map[string]int64{ "4182685520": 70000, }, HasDemoProfile:false},
-  4181603632: Warship{Name:"Öland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4181603632,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180555056": 160000, }, HasDemoProfile:false},
-  4182652752: Warship{Name:"Vauquelin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182652752,PriceCredit:5150000,Tier:7,NextShips:map[string]int64{ "4181604176": 110000, }, HasDemoProfile:false},
-  4181636880: Warship{Name:"Haarlem",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4181636880,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180588304": 170000, }, HasDemoProfile:false},
-  4180620752: Warship{Name:"Sovetsky Soyuz",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4180620752,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572176": 255000, }, HasDemoProfile:false},
-  4179572528: Warship{Name:"Grosser Kurfürst",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179572528,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4179605488: Warship{Name:"Midway",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4179605488,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4183734064: Warship{Name:"Nürnberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183734064,PriceCredit:3040000,Tier:6,NextShips:map[string]int64{ "4182685488": 70500, }, HasDemoProfile:false},
-  4181669712: Warship{Name:"Richelieu",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181669712,PriceCredit:10500000,Tier:8,NextShips:map[string]int64{ "4180621136": 190000, }, HasDemoProfile:false},
-  4181669328: Warship{Name:"Vladivostok",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181669328,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180620752": 180000, }, HasDemoProfile:false},
-  4180621136: Warship{Name:"Alsace",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180621136,PriceCredit:16900000,Tier:9,NextShips:map[string]int64{ "4179572560": 260000, }, HasDemoProfile:false},
-  4179507152: Warship{Name:"Daring",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179507152,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4182652112: Warship{Name:"Gadjah Mada",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4182652112,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181603536": 110000, }, HasDemoProfile:false},
-  4182685424: Warship{Name:"Zara",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4182685424,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4181636848": 105000, }, HasDemoProfile:false},
-  4182717904: Warship{Name:"Sinop",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182717904,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669328": 135000, }, HasDemoProfile:false},
-  4180588304: Warship{Name:"Johan de Witt",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4180588304,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179539728": 240000, }, HasDemoProfile:false},
-  4183766768: Warship{Name:"Andrea Doria",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4183766768,PriceCredit:3500000,Tier:6,NextShips:map[string]int64{ "4182718192": 80000, }, HasDemoProfile:false},
-  4179539152: Warship{Name:"Jinan",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4179539152,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4183766480: Warship{Name:"Izmail",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183766480,PriceCredit:3660000,Tier:6,NextShips:map[string]int64{ "4182717904": 80500, }, HasDemoProfile:false},
-  4183734032: Warship{Name:"Kijkduin",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4183734032,PriceCredit:3000000,Tier:6,NextShips:map[string]int64{ "4182685456": 72000, }, HasDemoProfile:false},
-  4182718288: Warship{Name:"Lyon",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182718288,PriceCredit:6000000,Tier:7,NextShips:map[string]int64{ "4181669712": 136000, }, HasDemoProfile:false},
-  4181604304: Warship{Name:"Lightning",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181604304,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180555728": 165000, }, HasDemoProfile:false},
-  4181603536: Warship{Name:"Hsienyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4181603536,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180554960": 160000, }, HasDemoProfile:false},
-  4181669680: Warship{Name:"Bismarck",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181669680,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621104": 180000, }, HasDemoProfile:false},
-  4179572688: Warship{Name:"Conqueror",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179572688,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4183766832: Warship{Name:"Bayern",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183766832,PriceCredit:3470000,Tier:6,NextShips:map[string]int64{ "4182718256": 80500, }, HasDemoProfile:false},
-  4183734000: Warship{Name:"Trento",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4183734000,PriceCredit:2900000,Tier:6,NextShips:map[string]int64{ "4182685424": 68000, }, HasDemoProfile:false},
-  4179572560: Warship{Name:"République",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179572560,PriceCredit:21000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4180621296: Warship{Name:"Minnesota",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4180621296,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4179572720": 255000, }, HasDemoProfile:false},
-  4182652880: Warship{Name:"Jervis",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182652880,PriceCredit:5150000,Tier:7,NextShips:map[string]int64{ "4181604304": 112000, }, HasDemoProfile:false},
-  4180587728: Warship{Name:"Sejong",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4180587728,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4182718256: Warship{Name:"Gneisenau",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182718256,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669680": 135000, }, HasDemoProfile:false},
-  4181702640: Warship{Name:"Lexington",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181702640,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605488": 355000, }, HasDemoProfile:false},
-  4182652368: Warship{Name:"Udaloi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182652368,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4074649040": 225000, }, HasDemoProfile:false},
-  4180621040: Warship{Name:"Lepanto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4180621040,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4179572464": 255000, }, HasDemoProfile:false},
-  4181669872: Warship{Name:"Kansas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181669872,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621296": 180000, }, HasDemoProfile:false},
-  4185896944: Warship{Name:"Langley",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4185896944,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799792": 40000, }, HasDemoProfile:false},
-  4184815440: Warship{Name:"Bretagne",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184815440,PriceCredit:1680000,Tier:5,NextShips:map[string]int64{ "4183766864": 42500, }, HasDemoProfile:false},
-  4187894992: Warship{Name:"Longjiang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4187894992,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4186846416": 2100, }, HasDemoProfile:false},
-  4188976592: Warship{Name:"Orlan",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4188976592,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928016": 350, }, HasDemoProfile:false},
-  4185830864: Warship{Name:"Svietlana",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185830864,PriceCredit:666000,Tier:4,NextShips:map[string]int64{ "4069438928": 11000, }, HasDemoProfile:false},
-  4186846512: Warship{Name:"Romulus",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4186846512,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4185797936": 6000, }, HasDemoProfile:false},
-  4267587280: Warship{Name:"Kamikaze R",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:4267587280,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4277024464: Warship{Name:"Fūjin",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:4277024464,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4247697392: Warship{Name:"Marblehead Lima",PriceGold:3100,Nation:"usa",IsPremium:true,ShipID:4247697392,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4276041712: Warship{Name:"Iowa",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4276041712,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4277090288": 247000, }, HasDemoProfile:false},
-  4187895760: Warship{Name:"Medea",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4187895760,PriceCredit:65000,Tier:2,NextShips:map[string]int64{ "4186847184": 2150, }, HasDemoProfile:false},
-  4185864016: Warship{Name:"Courbet",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185864016,PriceCredit:774000,Tier:4,NextShips:map[string]int64{ "4184815440": 12500, }, HasDemoProfile:false},
-  4186912208: Warship{Name:"Knyaz Suvorov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186912208,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185863632": 6700, }, HasDemoProfile:false},
-  4188976432: Warship{Name:"Gryf",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4188976432,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187895088": 350, }, HasDemoProfile:false},
-  4255037136: Warship{Name:"Atago",PriceGold:10700,Nation:"japan",IsPremium:true,ShipID:4255037136,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4248745968: Warship{Name:"Marblehead",PriceGold:3100,Nation:"usa",IsPremium:true,ShipID:4248745968,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4184750032: Warship{Name:"Acasta",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184750032,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183701456": 36000, }, HasDemoProfile:false},
-  4185798608: Warship{Name:"Wakeful",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185798608,PriceCredit:725000,Tier:4,NextShips:map[string]int64{ "4081039312": 11000,"4184750032": 11500, }, HasDemoProfile:false},
-  4188976336: Warship{Name:"Chengan",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4188976336,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187894992": 450, }, HasDemoProfile:false},
-  4185863984: Warship{Name:"Kaiser",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185863984,PriceCredit:774000,Tier:4,NextShips:map[string]int64{ "4184815408": 13000, }, HasDemoProfile:false},
-  4276008656: Warship{Name:"Tone",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:4276008656,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4185896400: Warship{Name:"Komsomolets",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185896400,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799248": 40000, }, HasDemoProfile:false},
-  4188976880: Warship{Name:"Eritrea",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4188976880,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928304": 300, }, HasDemoProfile:false},
-  4272895696: Warship{Name:"Izumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4272895696,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4276041424": 249000, }, HasDemoProfile:false},
-  4184782608: Warship{Name:"Celebes",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4184782608,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183734032": 36000, }, HasDemoProfile:false},
-  4187928528: Warship{Name:"Weymouth",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4187928528,PriceCredit:70000,Tier:2,NextShips:map[string]int64{ "4186879952": 1900,"4187895760": 650, }, HasDemoProfile:false},
-  4186847184: Warship{Name:"Valkyrie",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186847184,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185798608": 6000, }, HasDemoProfile:false},
-  4187895600: Warship{Name:"V-25",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4187895600,PriceCredit:75000,Tier:2,NextShips:map[string]int64{ "4186847024": 2000, }, HasDemoProfile:false},
-  4186912560: Warship{Name:"Nassau",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186912560,PriceCredit:318000,Tier:3,NextShips:map[string]int64{ "4082054960": 0,"4185863984": 6700, }, HasDemoProfile:false},
-  4184749776: Warship{Name:"Mutsuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4184749776,PriceCredit:1380000,Tier:5,NextShips:map[string]int64{ "4183701200": 34000, }, HasDemoProfile:false},
-  4185896656: Warship{Name:"Hōshō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4185896656,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799504": 40000, }, HasDemoProfile:false},
-  4272830448: Warship{Name:"Fletcher",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4272830448,PriceCredit:12850000,Tier:9,NextShips:map[string]int64{ "4281219056": 236000, }, HasDemoProfile:false},
-  4186912720: Warship{Name:"Bellerophon",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186912720,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185864144": 6700, }, HasDemoProfile:false},
-  4185831216: Warship{Name:"Karlsruhe",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185831216,PriceCredit:684000,Tier:4,NextShips:map[string]int64{ "4184782640": 11000, }, HasDemoProfile:false},
-  4183799760: Warship{Name:"Furious",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183799760,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702608": 161000, }, HasDemoProfile:false},
-  4184815408: Warship{Name:"König",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184815408,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766832": 40500, }, HasDemoProfile:false},
-  4187928304: Warship{Name:"Nino Bixio",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4187928304,PriceCredit:85000,Tier:2,NextShips:map[string]int64{ "4186879728": 2000, }, HasDemoProfile:false},
-  4258182864: Warship{Name:"Chikuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4258182864,PriceCredit:69000,Tier:2,NextShips:map[string]int64{ "4279154384": 1900,"4292753104": 720, }, HasDemoProfile:false},
-  4268635856: Warship{Name:"Kamikaze",PriceGold:2350,Nation:"japan",IsPremium:true,ShipID:4268635856,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4184782800: Warship{Name:"Emerald",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184782800,PriceCredit:1250000,Tier:5,NextShips:map[string]int64{ "4183734224": 33000, }, HasDemoProfile:false},
-  4183799600: Warship{Name:"Weser",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183799600,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702448": 161000, }, HasDemoProfile:false},
-  4256085712: Warship{Name:"Hashidate",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4256085712,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4258182864": 350, }, HasDemoProfile:false},
-  4184782576: Warship{Name:"Raimondo Montecuccoli",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4184782576,PriceCredit:1240000,Tier:5,NextShips:map[string]int64{ "4183734000": 32500, }, HasDemoProfile:false},
-  4186846672: Warship{Name:"Derzki",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186846672,PriceCredit:268000,Tier:3,NextShips:map[string]int64{ "4185798096": 6100, }, HasDemoProfile:false},
-  4259231440: Warship{Name:"Zaō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4259231440,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4188977104: Warship{Name:"Black Swan",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4188977104,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928528": 350, }, HasDemoProfile:false},
-  4185831376: Warship{Name:"Danae",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185831376,PriceCredit:698000,Tier:4,NextShips:map[string]int64{ "4079925200": 12000,"4184782800": 11000, }, HasDemoProfile:false},
-  4184749872: Warship{Name:"T-22",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184749872,PriceCredit:1320000,Tier:5,NextShips:map[string]int64{ "4183701296": 35000, }, HasDemoProfile:false},
-  4185896752: Warship{Name:"Rhein",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185896752,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799600": 40000, }, HasDemoProfile:false},
-  4184815344: Warship{Name:"Conte di Cavour",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4184815344,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766768": 42000, }, HasDemoProfile:false},
-  4187895248: Warship{Name:"Storozhevoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4187895248,PriceCredit:75300,Tier:2,NextShips:map[string]int64{ "4186846672": 2350, }, HasDemoProfile:false},
-  4185831152: Warship{Name:"Alberto di Giussano",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4185831152,PriceCredit:690000,Tier:4,NextShips:map[string]int64{ "4184782576": 10000,"4185863920": 7200, }, HasDemoProfile:false},
-  4264441840: Warship{Name:"Sims",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:4264441840,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4186879952: Warship{Name:"Caledon",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186879952,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4185831376": 5600,"4186912720": 2700, }, HasDemoProfile:false},
-  4188976912: Warship{Name:"Van Kinsbergen",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4188976912,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928336": 350, }, HasDemoProfile:false},
-  4185863920: Warship{Name:"Dante Alighieri",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4185863920,PriceCredit:775000,Tier:4,NextShips:map[string]int64{ "4184815344": 13000, }, HasDemoProfile:false},
-  4185798480: Warship{Name:"Bourrasque",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185798480,PriceCredit:725000,Tier:4,NextShips:map[string]int64{ "4184749904": 11500, }, HasDemoProfile:false},
-  4185798448: Warship{Name:"V-170",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185798448,PriceCredit:710000,Tier:4,NextShips:map[string]int64{ "4184749872": 11000,"4185896752": 11000, }, HasDemoProfile:false},
-  4183799504: Warship{Name:"Ryūjō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4183799504,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702352": 161000, }, HasDemoProfile:false},
-  4269717488: Warship{Name:"Phoenix",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4269717488,PriceCredit:660000,Tier:4,NextShips:map[string]int64{ "4289640432": 10500, }, HasDemoProfile:false},
-  4186847024: Warship{Name:"G-101",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186847024,PriceCredit:249000,Tier:3,NextShips:map[string]int64{ "4185798448": 6100, }, HasDemoProfile:false},
-  4186847056: Warship{Name:"Fusilier",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186847056,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185798480": 6000, }, HasDemoProfile:false},
-  4186879792: Warship{Name:"Kolberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186879792,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185831216": 5800,"4186912560": 2700, }, HasDemoProfile:false},
-  4259264496: Warship{Name:"New Mexico",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4259264496,PriceCredit:3450000,Tier:6,NextShips:map[string]int64{ "4286527472": 72500, }, HasDemoProfile:false},
-  4183766992: Warship{Name:"Queen Elizabeth",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183766992,PriceCredit:3660000,Tier:6,NextShips:map[string]int64{ "4182718416": 80500, }, HasDemoProfile:false},
-  4186879824: Warship{Name:"Friant",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186879824,PriceCredit:247000,Tier:3,NextShips:map[string]int64{ "4185831248": 5600,"4186912592": 2850, }, HasDemoProfile:false},
-  4184749360: Warship{Name:"Visby",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4184749360,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183700784": 35000, }, HasDemoProfile:false},
-  4184749904: Warship{Name:"Jaguar",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184749904,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183701328": 35500, }, HasDemoProfile:false},
-  4183799248: Warship{Name:"Serov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183799248,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702096": 161000, }, HasDemoProfile:false},
-  4184782640: Warship{Name:"Königsberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184782640,PriceCredit:1280000,Tier:5,NextShips:map[string]int64{ "4183734064": 34000, }, HasDemoProfile:false},
-  4184782672: Warship{Name:"Émile Bertin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184782672,PriceCredit:1200000,Tier:5,NextShips:map[string]int64{ "4183734096": 33500, }, HasDemoProfile:false},
-  4183799792: Warship{Name:"Ranger",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4183799792,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702640": 161000, }, HasDemoProfile:false},
-  4184815568: Warship{Name:"Iron Duke",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184815568,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766992": 45000, }, HasDemoProfile:false},
-  4186912592: Warship{Name:"Turenne",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186912592,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185864016": 6600, }, HasDemoProfile:false},
-  4186879440: Warship{Name:"Bogatyr",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186879440,PriceCredit:254000,Tier:3,NextShips:map[string]int64{ "4185830864": 5700,"4186912208": 2700, }, HasDemoProfile:false},
-  4185797936: Warship{Name:"Klas Horn",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4185797936,PriceCredit:709000,Tier:4,NextShips:map[string]int64{ "4184749360": 11000, }, HasDemoProfile:false},
-  4269684432: Warship{Name:"Wakatake",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4269684432,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4291704528": 5100, }, HasDemoProfile:false},
-  4276041424: Warship{Name:"Yamato",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4276041424,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4185831248: Warship{Name:"Duguay-Trouin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185831248,PriceCredit:661000,Tier:4,NextShips:map[string]int64{ "4184782672": 10500, }, HasDemoProfile:false},
-  4187928016: Warship{Name:"Novik",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4187928016,PriceCredit:69300,Tier:2,NextShips:map[string]int64{ "4186879440": 2150,"4187895248": 500, }, HasDemoProfile:false},
-  4186879760: Warship{Name:"Java",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4186879760,PriceCredit:250000,Tier:3,NextShips:map[string]int64{ "4185831184": 5600, }, HasDemoProfile:false},
-  4274927600: Warship{Name:"Clemson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4274927600,PriceCredit:720000,Tier:4,NextShips:map[string]int64{ "4185896944": 11000,"4280170480": 11000, }, HasDemoProfile:false},
-  4187895088: Warship{Name:"Tátra",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4187895088,PriceCredit:72000,Tier:2,NextShips:map[string]int64{ "4186846512": 2000, }, HasDemoProfile:false},
-  4187928400: Warship{Name:"Jurien de la Gravière",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4187928400,PriceCredit:70000,Tier:2,NextShips:map[string]int64{ "4186879824": 2150,"4187895632": 650, }, HasDemoProfile:false},
-  4186846416: Warship{Name:"Phra Ruang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4186846416,PriceCredit:252000,Tier:3,NextShips:map[string]int64{ "4185797840": 5600, }, HasDemoProfile:false},
-  4184749264: Warship{Name:"Jianwei",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4184749264,PriceCredit:1320000,Tier:5,NextShips:map[string]int64{ "4183700688": 34500, }, HasDemoProfile:false},
-  4185864144: Warship{Name:"Orion",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185864144,PriceCredit:765000,Tier:4,NextShips:map[string]int64{ "4184815568": 13000, }, HasDemoProfile:false},
-  4185831184: Warship{Name:"De Ruyter",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4185831184,PriceCredit:700000,Tier:4,NextShips:map[string]int64{ "4184782608": 11500, }, HasDemoProfile:false},
-  4184815056: Warship{Name:"Pyotr Velikiy",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4184815056,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766480": 45000, }, HasDemoProfile:false},
-  4186879728: Warship{Name:"Taranto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4186879728,PriceCredit:270000,Tier:3,NextShips:map[string]int64{ "4185831152": 5250, }, HasDemoProfile:false},
-  4267620048: Warship{Name:"Iwaki Alpha",PriceGold:2650,Nation:"japan",IsPremium:true,ShipID:4267620048,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4187895632: Warship{Name:"Enseigne Gabolde",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4187895632,PriceCredit:65000,Tier:2,NextShips:map[string]int64{ "4186847056": 2100, }, HasDemoProfile:false},
-  4188976976: Warship{Name:"Bougainville",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4188976976,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928400": 350, }, HasDemoProfile:false},
-  4273911792: Warship{Name:"Des Moines",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4273911792,PriceCredit:19800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4184782032: Warship{Name:"Chungking",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4184782032,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:true},
-  4185797840: Warship{Name:"Shenyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4185797840,PriceCredit:706000,Tier:4,NextShips:map[string]int64{ "4184749264": 11000, }, HasDemoProfile:false},
-  4266538992: Warship{Name:"Wickes",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4266538992,PriceCredit:250000,Tier:3,NextShips:map[string]int64{ "4274927600": 6000, }, HasDemoProfile:false},
-  4185798096: Warship{Name:"Izyaslav",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185798096,PriceCredit:711000,Tier:4,NextShips:map[string]int64{ "4079891920": 11000,"4185896400": 11000, }, HasDemoProfile:false},
-  4187928336: Warship{Name:"Gelderland",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4187928336,PriceCredit:75000,Tier:2,NextShips:map[string]int64{ "4186879760": 2200, }, HasDemoProfile:false},
-  4185863632: Warship{Name:"Gangut",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185863632,PriceCredit:765000,Tier:4,NextShips:map[string]int64{ "4184815056": 13000, }, HasDemoProfile:false},
-  4289640144: Warship{Name:"Furutaka",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4289640144,PriceCredit:1370000,Tier:5,NextShips:map[string]int64{ "4287542992": 30000, }, HasDemoProfile:false},
-  4288624336: Warship{Name:"Fusō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4288624336,PriceCredit:3490000,Tier:6,NextShips:map[string]int64{ "4284430032": 78500, }, HasDemoProfile:false},
-  4288559088: Warship{Name:"Mahan",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4288559088,PriceCredit:5350000,Tier:7,NextShips:map[string]int64{ "4286461936": 115000, }, HasDemoProfile:false},
-  4293834736: Warship{Name:"Erie",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4293834736,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4292786160": 350, }, HasDemoProfile:false},
-  4283381456: Warship{Name:"Mikasa",PriceGold:2000,Nation:"japan",IsPremium:true,ShipID:4283381456,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4290688720: Warship{Name:"Yūbari",PriceGold:2250,Nation:"japan",IsPremium:true,ShipID:4290688720,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4284397008: Warship{Name:"Diana Lima",PriceGold:1650,Nation:"ussr",IsPremium:true,ShipID:4284397008,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4293866960: Warship{Name:"Imperator Nikolai I",PriceGold:2800,Nation:"ussr",IsPremium:true,ShipID:4293866960,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292785616: Warship{Name:"Diana",PriceGold:1650,Nation:"ussr",IsPremium:true,ShipID:4292785616,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4291704528: Warship{Name:"Isokaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4291704528,PriceCredit:705000,Tier:4,NextShips:map[string]int64{ "4184749776": 11000,"4185896656": 11000,"4290655952": 11500, }, HasDemoProfile:false},
-  4291770064: Warship{Name:"Myōgi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4291770064,PriceCredit:775000,Tier:4,NextShips:map[string]int64{ "4287575760": 12000, }, HasDemoProfile:false},
-  4286461936: Warship{Name:"Benson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4286461936,PriceCredit:9040000,Tier:8,NextShips:map[string]int64{ "4272830448": 158000, }, HasDemoProfile:false},
-  4281219056: Warship{Name:"Gearing",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4281219056,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292818896: Warship{Name:"Warspite",PriceGold:6500,Nation:"uk",IsPremium:true,ShipID:4292818896,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4288591856: Warship{Name:"Atlanta",PriceGold:9000,Nation:"usa",IsPremium:true,ShipID:4288591856,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292818736: Warship{Name:"Tirpitz",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:4292818736,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292785968: Warship{Name:"Dresden",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4292785968,PriceCredit:67300,Tier:2,NextShips:map[string]int64{ "4186879792": 2200,"4187895600": 500, }, HasDemoProfile:false},
-  4279154384: Warship{Name:"Tenryū",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4279154384,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4281251536": 5600,"4293867216": 2600, }, HasDemoProfile:false},
-  4293867216: Warship{Name:"Kawachi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4293867216,PriceCredit:318000,Tier:3,NextShips:map[string]int64{ "4291770064": 6700, }, HasDemoProfile:false},
-  4289640432: Warship{Name:"Omaha",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4289640432,PriceCredit:1200000,Tier:5,NextShips:map[string]int64{ "4078876656": 38500,"4183734256": 33000, }, HasDemoProfile:false},
-  4293834192: Warship{Name:"Aurora",PriceGold:2250,Nation:"ussr",IsPremium:true,ShipID:4293834192,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292753104: Warship{Name:"Umikaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4292753104,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4269684432": 2000, }, HasDemoProfile:false},
-  4281251536: Warship{Name:"Kuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4281251536,PriceCredit:684000,Tier:4,NextShips:map[string]int64{ "4289640144": 11500, }, HasDemoProfile:false},
-  4281284592: Warship{Name:"Arkansas Beta",PriceGold:2850,Nation:"usa",IsPremium:true,ShipID:4281284592,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4280170192: Warship{Name:"Tachibana Lima",PriceGold:950,Nation:"japan",IsPremium:true,ShipID:4280170192,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4287542992: Warship{Name:"Aoba",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4287542992,PriceCredit:2660000,Tier:6,NextShips:map[string]int64{ "4286494416": 72000, }, HasDemoProfile:false},
-  4286527184: Warship{Name:"Ishizuchi",PriceGold:2500,Nation:"japan",IsPremium:true,ShipID:4286527184,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4293867504: Warship{Name:"South Carolina",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4293867504,PriceCredit:309000,Tier:3,NextShips:map[string]int64{ "4290721776": 7200, }, HasDemoProfile:false},
-  4290689008: Warship{Name:"St. Louis",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4290689008,PriceCredit:247000,Tier:3,NextShips:map[string]int64{ "4269717488": 5600,"4293867504": 2800, }, HasDemoProfile:false},
-  4277090288: Warship{Name:"Montana",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4277090288,PriceCredit:20800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4293801680: Warship{Name:"Tachibana",PriceGold:950,Nation:"japan",IsPremium:true,ShipID:4293801680,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4286494416: Warship{Name:"Myōkō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4286494416,PriceCredit:5800000,Tier:7,NextShips:map[string]int64{ "4285445840": 109000, }, HasDemoProfile:false},
-  4280170480: Warship{Name:"Nicholas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4280170480,PriceCredit:1300000,Tier:5,NextShips:map[string]int64{ "4289607664": 35500, }, HasDemoProfile:false},
-  4282333168: Warship{Name:"North Carolina",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4282333168,PriceCredit:9800000,Tier:8,NextShips:map[string]int64{ "4276041712": 175000, }, HasDemoProfile:false},
-  4281284304: Warship{Name:"Amagi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4281284304,PriceCredit:10900000,Tier:8,NextShips:map[string]int64{ "4272895696": 178000, }, HasDemoProfile:false},
-  4287575760: Warship{Name:"Kongō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4287575760,PriceCredit:1420000,Tier:5,NextShips:map[string]int64{ "4288624336": 42500, }, HasDemoProfile:false},
-  4293834544: Warship{Name:"Hermelin",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4293834544,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4292785968": 350, }, HasDemoProfile:false},
-  4292753392: Warship{Name:"Sampson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4292753392,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4266538992": 2200, }, HasDemoProfile:false},
-  4284430032: Warship{Name:"Nagato",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4284430032,PriceCredit:6350000,Tier:7,NextShips:map[string]int64{ "4281284304": 135000, }, HasDemoProfile:false},
-  4286527472: Warship{Name:"Colorado",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4286527472,PriceCredit:6000000,Tier:7,NextShips:map[string]int64{ "4181669872": 140000,"4282333168": 120000, }, HasDemoProfile:false},
-  4282300112: Warship{Name:"Ibuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4282300112,PriceCredit:12500000,Tier:9,NextShips:map[string]int64{ "4259231440": 238000, }, HasDemoProfile:false},
-  4288624624: Warship{Name:"New York",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4288624624,PriceCredit:1500000,Tier:5,NextShips:map[string]int64{ "4259264496": 40000, }, HasDemoProfile:false},
-  4290721776: Warship{Name:"Wyoming",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4290721776,PriceCredit:785000,Tier:4,NextShips:map[string]int64{ "4288624624": 13000, }, HasDemoProfile:false},
-  4291737584: Warship{Name:"Albany",PriceGold:1550,Nation:"usa",IsPremium:true,ShipID:4291737584,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4282267344: Warship{Name:"Shimakaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4282267344,PriceCredit:19300000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4292786160: Warship{Name:"Chester",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4292786160,PriceCredit:69300,Tier:2,NextShips:map[string]int64{ "4290689008": 2200,"4292753392": 720, }, HasDemoProfile:false},
-  4293801424: Warship{Name:"Gremyashchy",PriceGold:2350,Nation:"ussr",IsPremium:true,ShipID:4293801424,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4285445840: Warship{Name:"Mogami",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4285445840,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4282300112": 151000, }, HasDemoProfile:false},
-  4291737040: Warship{Name:"Murmansk",PriceGold:3000,Nation:"ussr",IsPremium:true,ShipID:4291737040,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false},
-  4289607664: Warship{Name:"Farragut",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4289607664,PriceCredit:3120000,Tier:6,NextShips:map[string]int64{ "4288559088": 65000, }, HasDemoProfile:false},
-  4290655952: Warship{Name:"Minekaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4290655952,PriceCredit:1380000,Tier:5,NextShips:map[string]int64{ "4078843600": 34000, }, HasDemoProfile:false},
+  3743364816: Warship{Name:"Ise",PriceGold:6500,Nation:"japan",IsPremium:true,ShipID:3743364816,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB526_eb56dd993a0f99983b78ddf1f5660ab56dbf947da110120aa0a4f0c0473cbecc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ise", }, Group:"", SpecialGroup:""},
+  3553572848: Warship{Name:"California",PriceGold:9500,Nation:"usa",IsPremium:true,ShipID:3553572848,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB707_7eb611b345b2aff603d2bc2b2a579c56ab69d0ac8a3d3a241fd9167abdb68fe4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "California", }, Group:"", SpecialGroup:""},
+  3541972176: Warship{Name:"Ship Smasha",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:3541972176,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD718_c5304d13ad32acb4f205a713cf10af357e422a862e9542e25e230c630ba4bbb5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ship Smasha", }, Group:"", SpecialGroup:""},
+  3741234640: Warship{Name:"Ochakov",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3741234640,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC528_c0c4d94ec7f35fd60479049912b3dc65050b8517e2e97be98a70bb664ae8c68c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ochakov", }, Group:"", SpecialGroup:""},
+  3655251408: Warship{Name:"Smolensk",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3655251408,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC610_c070b9c9a3b1ca9f05515c50feea1fb0f59cb56f85d7fa50c05903b8463b6a8e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Smolensk", }, Group:"", SpecialGroup:""},
+  3655218480: Warship{Name:"Småland",PriceGold:34650,Nation:"europe",IsPremium:false,ShipID:3655218480,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD610_4bb7261b1c22c1fd2e99ae07df98a25f6d5c5aa6e7f882b4e29e5bc4f90405a3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Småland", }, Group:"", SpecialGroup:""},
+  3338548944: Warship{Name:"[Shimakaze]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3338548944,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD912_abe195480c5687e0a492b11dcd83d8117b278c8c3aa8bc794b3df3ed77c21c26.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Shimakaze]", }, Group:"", SpecialGroup:""},
+  3667801808: Warship{Name:"Asashio B",PriceGold:9300,Nation:"japan",IsPremium:true,ShipID:3667801808,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD598_37f1b2ae434f9be06c6d355c59df63abf6658a144b5a74ed95799b6026fe61df.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Asashio B", }, Group:"", SpecialGroup:"b_variant"},
+  3749623504: Warship{Name:"Yoshino",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3749623504,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC520_7e7757907a51a278d771f99c39c7dd40f538c9e1ff919d1d185d960fe6029a3a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yoshino", }, Group:"", SpecialGroup:""},
+  3553540080: Warship{Name:"Flint",PriceGold:10000,Nation:"usa",IsPremium:true,ShipID:3553540080,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC707_9a53bf9f926e7f9199ed84b19e8ab76ac7dd0559502728123fa9908c7d8b0343.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Flint", }, Group:"", SpecialGroup:""},
+  3340744656: Warship{Name:"[Audacious]",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340744656,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA910_f5d7acb803490a2cf8ca59704d483f3b61f0e5a58ecd19e30ae1b882e200ba4d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Audacious]", }, Group:"", SpecialGroup:""},
+  3551475152: Warship{Name:"AL Sov. Rossiya",PriceGold:19000,Nation:"ussr",IsPremium:true,ShipID:3551475152,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB709_2290436f7d129b1609fa9dfd4d77363818bcca8984d9510d174198740c25798b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "AL Sov. Rossiya", }, Group:"", SpecialGroup:"azur_lane"},
+  3667834576: Warship{Name:"Atago B",PriceGold:10700,Nation:"japan",IsPremium:true,ShipID:3667834576,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC598_bba0e960fd4bbfdad4394c90ad97ab39fe714b2b23c7e01402b9843c238779ef.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Atago B", }, Group:"", SpecialGroup:"b_variant"},
+  3554555600: Warship{Name:"Shinonome",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:3554555600,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD706_415338153df91de8614c59fb4227266483dffe85fc4c98f40285a942fa2c8371.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shinonome", }, Group:"", SpecialGroup:""},
+  3532568272: Warship{Name:"Eastern Dragon",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3532568272,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC727_9b70be9b1d1890fafe3ceaac99d99577c0a1f93b52be6aa816b9d10b67fec6fc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Eastern Dragon", }, Group:"", SpecialGroup:""},
+  3560912592: Warship{Name:"ARP Yamato",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3560912592,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB700_aac1f748d94cedc55af86a52f449981bceafd6bec48e1ebf145dc3e10ea70161.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Yamato", }, Group:"", SpecialGroup:"arp"},
+  3555670000: Warship{Name:"Texas",PriceGold:5150,Nation:"usa",IsPremium:true,ShipID:3555670000,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB705_e629e475efaf1e29292b717f4d5b05b417d61aa78bd9b286a48f8cb8d8da0f2e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Texas", }, Group:"", SpecialGroup:""},
+  3667900400: Warship{Name:"Saipan B",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3667900400,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA598_7a2140079b88908321b2e24d5948bbed3a9ff254f54c163568fb0377b1ee05ae.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Saipan B", }, Group:"", SpecialGroup:"b_variant"},
+  3332323024: Warship{Name:"[Yamato]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3332323024,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB918_bb608ae8c89cac27791627deb721463af6cb68edcf370154c3081db0d386d9db.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Yamato]", }, Group:"", SpecialGroup:""},
+  3340711888: Warship{Name:"[Conqueror]",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340711888,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB910_135ede5e893e5f2cf148f7a8d1b81fdc2bbb130a2855cc2c76c1c24fe9eef604.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Conqueror]", }, Group:"", SpecialGroup:""},
+  3315513040: Warship{Name:"[Zaō]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3315513040,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC934_4ff33cd01dbf1be2998e56d24f0ebd229f96b0f45183925996660da926b0bb2b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Zaō]", }, Group:"", SpecialGroup:""},
+  3545183952: Warship{Name:"HSF Hiei",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3545183952,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB715_5e4000ee9a7f2005fae373f40fd331a20e1ba5d357efc746619931b5de530dad.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "HSF Hiei", }, Group:"", SpecialGroup:"hsf"},
+  3340679120: Warship{Name:"Cyclops",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:3340679120,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC910_3ed2b8e28c6b5fef34c9709aff78a38a5d1af08ed13bd89d5a3528113167d6c6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cyclops", }, Group:"", SpecialGroup:""},
+  3740153648: Warship{Name:"ZF-6",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3740153648,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD529_fadc14189b1b3070a39b96dc0295f8fc124d7e8391ff99eee7ac1df190f2ce15.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ZF-6", }, Group:"", SpecialGroup:""},
+  3730749424: Warship{Name:"Rochester",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3730749424,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC538_7f9689bf07467198681f95ce1d1673af92a811f8f9cb8da0f9a8814af5ab438c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Rochester", }, Group:"", SpecialGroup:""},
+  3666818864: Warship{Name:"Pommern B",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3666818864,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB599_46710756c18f2d4a46afc6c7aba6f214b74a181a3871952f4e10c5ff16e336bd.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pommern B", }, Group:"", SpecialGroup:"b_variant"},
+  3445503440: Warship{Name:"DD R-10",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3445503440,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD810_6680c4d13651de94ab2befc72cd40e440d097645570edb7dbfc490cbc64502ed.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "DD R-10", }, Group:"", SpecialGroup:""},
+  3333404368: Warship{Name:"[Hakuryū]",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3333404368,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA917_00695fda2927f4e847c390fef1fc00a72ecb9eeebb7cfa439fdb696dea539764.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Hakuryū]", }, Group:"", SpecialGroup:""},
+  3741202128: Warship{Name:"HSF Harekaze II",PriceGold:10000,Nation:"japan",IsPremium:true,ShipID:3741202128,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD528_78ac87999a30de255a8070969c776238a9bc674057b7e9285b4ab6e7cd7d1401.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "HSF Harekaze II", }, Group:"", SpecialGroup:"hsf"},
+  3551442640: Warship{Name:"ARP Haguro",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3551442640,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC709_0bee87c13a1dfa0a474a288e353ffa1337eff7ced7b08b93e5359bcd964083ae.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Haguro", }, Group:"", SpecialGroup:"arp"},
+  3445536752: Warship{Name:"Austin",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3445536752,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC810_ea0c5970966143de80c231c68b76d834b84258c2cecbb80832185408dc24770d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Austin", }, Group:"", SpecialGroup:""},
+  3742316496: Warship{Name:"Duke of York",PriceGold:9800,Nation:"uk",IsPremium:true,ShipID:3742316496,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB527_1baf46c76e87e712a84fd1bb04b1042896373ae8fc4d03ad789f949d3cfff7f5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Duke of York", }, Group:"", SpecialGroup:""},
+  3667802064: Warship{Name:"Cossack B",PriceGold:9600,Nation:"uk",IsPremium:true,ShipID:3667802064,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD598_8f2a84eede618cb10aec0de1543e94c2f262397d2b2641e42dea31637283505e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cossack B", }, Group:"", SpecialGroup:"b_variant"},
+  3554588464: Warship{Name:"HSF Admiral Graf Spee",PriceGold:5100,Nation:"germany",IsPremium:true,ShipID:3554588464,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC706_b3c99f5ac9380a04b653d4f05cdab282c0b6ef2679969e9509ff202e46f7a957.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "HSF Admiral Graf Spee", }, Group:"", SpecialGroup:"hsf"},
+  3676223184: Warship{Name:"Yoshino B",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3676223184,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC590_979f0c73c298ef5d2e4860ca11e500ce23c8c0fc8ea820c83c3462bb8c9cb677.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yoshino B", }, Group:"", SpecialGroup:"b_variant"},
+  3340678608: Warship{Name:"[Moskva]",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3340678608,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC910_b0e51720e691e7e5e52ae2d5924e23224cbc0533d9f1080ac3c29f183a92fec3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Moskva]", }, Group:"", SpecialGroup:""},
+  3659445712: Warship{Name:"Admiral Makarov",PriceGold:5100,Nation:"ussr",IsPremium:true,ShipID:3659445712,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC606_a5298dbaca9b2f8f3779755dc75e192b895dbdb408cd4428204befc592b8cf90.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Admiral Makarov", }, Group:"", SpecialGroup:""},
+  3552491216: Warship{Name:"ARP Takao",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3552491216,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC708_4110aecb47a60c899277f6a2b3c7af1afa89a5af1b95c7230bceb0f17781cc71.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Takao", }, Group:"", SpecialGroup:"arp"},
+  3340711920: Warship{Name:"Connecticut",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3340711920,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB910_72d6a5d57676a979336aae31420bb74a9582232d0fd2bc1586187b8afccd75ac.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Connecticut", }, Group:"", SpecialGroup:""},
+  3667867440: Warship{Name:"Tirpitz B",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3667867440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB598_38cafa84763ff00a5ac34e17d885785f994e37deee48725316fc4a9c2bbdbdbb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tirpitz B", }, Group:"", SpecialGroup:"b_variant"},
+  3668916016: Warship{Name:"Scharnhorst B",PriceGold:9500,Nation:"germany",IsPremium:true,ShipID:3668916016,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB597_54bceef12333d0aa0f5e8c1596a5121489ec4306e5885b0fee11f05ab592eecc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Scharnhorst B", }, Group:"", SpecialGroup:"b_variant"},
+  3744380368: Warship{Name:"Kirov",PriceGold:4000,Nation:"ussr",IsPremium:true,ShipID:3744380368,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC525_e249695ca9a07095732eec22882e76ef17a7450b2c60c2fc27d8c01314e63af8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kirov", }, Group:"", SpecialGroup:""},
+  3340645680: Warship{Name:"Lappland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:3340645680,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD910_f62fe24ced8906191ddfdff91730e3a9ac2daf46b63205d3738bcee9a1e0096c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lappland", }, Group:"", SpecialGroup:""},
+  3541972688: Warship{Name:"AL Yukikaze",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3541972688,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD718_62a565b1237520073586662c48574180495886066e3efac59d8a53826b980bdd.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "AL Yukikaze", }, Group:"", SpecialGroup:"azur_lane"},
+  3553539792: Warship{Name:"ARP Ashigara",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3553539792,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC707_213faafb421e9bb32f65f5fadfb25196728b379f80f8d6f90152507707fc9d52.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Ashigara", }, Group:"", SpecialGroup:"arp"},
+  3363780304: Warship{Name:"Ragnarok",PriceGold:12500,Nation:"japan",IsPremium:true,ShipID:3363780304,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB888_06eb5fff68baf1797f0b39b1662a164607650664f26516cc725ac5822785fa0d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ragnarok", }, Group:"", SpecialGroup:""},
+  3655317296: Warship{Name:"Max Immelmann",PriceGold:34650,Nation:"germany",IsPremium:false,ShipID:3655317296,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA610_ba8c1756ffcbe1dc6ada25f62b1d1d13f43b9782f41294babd5f7978958aa57f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Max Immelmann", }, Group:"", SpecialGroup:""},
+  3555636944: Warship{Name:"ARP Myōkō",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3555636944,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC705_23d7274014edd5a4f8b70e8367b267e7ff5289934e05624538bd412622b2be13.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Myōkō", }, Group:"", SpecialGroup:"arp"},
+  3655251664: Warship{Name:"Kitakami",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3655251664,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC610_adecd618e72e636b2ed30e1568e898373f690d9c2b9107cada0bde5662e4bc94.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kitakami", }, Group:"", SpecialGroup:""},
+  3374266064: Warship{Name:"Ignis Purgatio",PriceGold:12500,Nation:"japan",IsPremium:true,ShipID:3374266064,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB878_7da292fcfce70b274bceef1c316ba55f8cb5b87077a80de4df48f03334c56282.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ignis Purgatio", }, Group:"", SpecialGroup:""},
+  3542005552: Warship{Name:"Cross of Dorn",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3542005552,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC718_e703b27f8d716fe82d41d955492784da73d8dfe435b4135c472aa56165f81eae.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cross of Dorn", }, Group:"", SpecialGroup:""},
+  3658397424: Warship{Name:"Gorizia",PriceGold:8500,Nation:"italy",IsPremium:true,ShipID:3658397424,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC607_17eb47772f4ca5ce8f86395e8968161c1bc745dc239980e14e0577230e1037d9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gorizia", }, Group:"", SpecialGroup:""},
+  3741267408: Warship{Name:"Borodino",PriceGold:12500,Nation:"ussr",IsPremium:true,ShipID:3741267408,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB528_cea8b33fad5e89c333bf33da29f9a7fcab29fdb36d8bf62bb754c8712c58ed4b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Borodino", }, Group:"", SpecialGroup:""},
+  3668883440: Warship{Name:"Boise",PriceGold:8500,Nation:"usa",IsPremium:true,ShipID:3668883440,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC597_f157c4b6aa57582cb9336671d6838731b072756d5cc6cb64e54d12598fd16d2d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Boise", }, Group:"", SpecialGroup:""},
+  3522082512: Warship{Name:"ARP Nachi",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3522082512,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC737_a1448e104c2a97c9947056916b64be6dcd505f06b2dc92d4c506cce31b605ba2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Nachi", }, Group:"", SpecialGroup:"arp"},
+  3550394352: Warship{Name:"Salem",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3550394352,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC710_295243134f7325711607add114f258af9989cbd388a74b1cd834604f92644008.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Salem", }, Group:"", SpecialGroup:""},
+  3551410160: Warship{Name:"Black",PriceGold:20000,Nation:"usa",IsPremium:true,ShipID:3551410160,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD709_f7d89956ba9d7ecafb01b89729fa011f898faac2adb169cf573ec58e2c58755f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Black", }, Group:"", SpecialGroup:""},
+  3730748880: Warship{Name:"Pyotr Bagration",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3730748880,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC538_3da61de0ff82ae273665c0db17c31567081fd0bfee1cfc41d97a07c9ade70f0d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pyotr Bagration", }, Group:"", SpecialGroup:""},
+  3666818896: Warship{Name:"Jean Bart B",PriceGold:19200,Nation:"france",IsPremium:true,ShipID:3666818896,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB599_9892f44422683c22bb293422576e9a2c0394086facae33b23e6da8b98e6a7b31.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jean Bart B", }, Group:"", SpecialGroup:"b_variant"},
+  3667900208: Warship{Name:"Graf Zeppelin B",PriceGold:13700,Nation:"germany",IsPremium:true,ShipID:3667900208,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA598_a08db6cf69c6da5bd41678097d29ee568c4b94db2cc73e66312dfc13c0afb4ec.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Graf Zeppelin B", }, Group:"", SpecialGroup:"b_variant"},
+  3655251952: Warship{Name:"Puerto Rico",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3655251952,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC610_aa72826f34980bcb115fd2d2515dd0df889d04141495b1c00cf59f3f089289eb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Puerto Rico", }, Group:"", SpecialGroup:""},
+  3750639408: Warship{Name:"Z-44",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3750639408,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD519_f05462005a9f458b20950a5419ff24879ebc2e232d72a50c3bb630571181a33b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-44", }, Group:"", SpecialGroup:""},
+  3340678960: Warship{Name:"[Hindenburg]",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3340678960,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC910_4635332915d94f64dbdc1fda94f7f2f0bfd227355eab5406b16f5d2e81006c3d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Hindenburg]", }, Group:"", SpecialGroup:""},
+  3542005744: Warship{Name:"AL Montpelier",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3542005744,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC718_31402e349e7f4a854911ec9ab44c5d6b57d248367013c6361ea9926d8171583a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "AL Montpelier", }, Group:"", SpecialGroup:"azur_lane"},
+  3552523984: Warship{Name:"ARP Hiei",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3552523984,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB708_570188fd8cd03c7a4a8a56d66a22b1968112039e7b474bff33a79570c38522a8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Hiei", }, Group:"", SpecialGroup:"arp"},
+  3530504176: Warship{Name:"Georgia",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3530504176,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB729_7e0a36575fdaaf6c64b52c86434e3e9f11d1b722076090bb0cf83eac6d4f1dcb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Georgia", }, Group:"", SpecialGroup:""},
+  3730782192: Warship{Name:"Constellation",PriceGold:12500,Nation:"usa",IsPremium:true,ShipID:3730782192,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB538_79c302cd4466b56622250c797fd80801d7315e1976b9b8d77916c883e764980a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Constellation", }, Group:"", SpecialGroup:""},
+  3550360880: Warship{Name:"Ragnar",PriceGold:34650,Nation:"europe",IsPremium:false,ShipID:3550360880,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD710_3a022f6da763ef0e47b83c3f12d1335bcd3073f5f5380f46079d628ad4636642.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ragnar", }, Group:"", SpecialGroup:""},
+  3741267792: Warship{Name:"Champagne",PriceGold:12500,Nation:"france",IsPremium:true,ShipID:3741267792,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB528_8bd040792a9c8303d866cc8ea1c4f6aafe80ab24c9c2072749b4f247707d8c61.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Champagne", }, Group:"", SpecialGroup:""},
+  3749623248: Warship{Name:"STALINGRAD #2",PriceGold:99999,Nation:"ussr",IsPremium:false,ShipID:3749623248,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC520_61b2d5bfa3e70ca626254c18e1ef0ebf005fa57b216926f053e9af60ebfa026b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "STALINGRAD #2", }, Group:"", SpecialGroup:""},
+  3340711728: Warship{Name:"[Grosser Kurfürst]",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3340711728,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB910_9b3dbcaf7d4508435c97bc3b30de24490dc70b24cf6e49794f0681db9ec86bfe.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Grosser Kurfürst]", }, Group:"", SpecialGroup:""},
+  3542005456: Warship{Name:"ARP Maya",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3542005456,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC718_f885f6ddcae785d34f43eb24636666d965612e1cde3fbf3ec2381c6cfd81205e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Maya", }, Group:"", SpecialGroup:"arp"},
+  3553572560: Warship{Name:"ARP Haruna",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3553572560,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB707_7af8b7cf8b82825eea1035176724647ddd617f8c1e245c8e791a8999f3692d50.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Haruna", }, Group:"", SpecialGroup:"arp"},
+  3555669712: Warship{Name:"ARP Kongō",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3555669712,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB705_72bc7d64bc57d7eb578c04cde318f641085b81042b74de3f0eec7070487b15ea.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Kongō", }, Group:"", SpecialGroup:"arp"},
+  3335501808: Warship{Name:"[Midway]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3335501808,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA915_4d771eaae5b865a45b94b367db15a494aa27262a7d90c215fdc6bf447e6ee219.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Midway]", }, Group:"", SpecialGroup:""},
+  3669964624: Warship{Name:"Dunkerque\u00a0B",PriceGold:5200,Nation:"france",IsPremium:true,ShipID:3669964624,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB596_83569f8d8481ce21ec2df36e137d2a80499274e011449e3a8e0b2da88a5c6cf0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dunkerque\u00a0B", }, Group:"", SpecialGroup:""},
+  3337500656: Warship{Name:"[Gearing]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3337500656,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD913_cf35f9528df69fef9880f2d65cf467010068268307c5f679cc2f2b2b93bc26d5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Gearing]", }, Group:"", SpecialGroup:""},
+  3543054032: Warship{Name:"Southern Dragon",PriceGold:8500,Nation:"japan",IsPremium:true,ShipID:3543054032,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC717_ca2d68d62f1560db2fd711fb1ea8d93d05457c51d4a9212900758c7a9697e023.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Southern Dragon", }, Group:"", SpecialGroup:""},
+  3554621136: Warship{Name:"ARP Kirishima",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:3554621136,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB706_11cb909a60e1cce16449c0309326e3686c69ba9f131c80a9da2d52cc11c4b0d9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "ARP Kirishima", }, Group:"", SpecialGroup:"arp"},
+  3679369200: Warship{Name:"Atlanta B",PriceGold:9000,Nation:"usa",IsPremium:true,ShipID:3679369200,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC587_79174b605e9d4fb33a2c4e324e7e2f03aafbe20ff59575a4b31344fbf499c5a3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Atlanta B", }, Group:"", SpecialGroup:"b_variant"},
+  3668850672: Warship{Name:"Sims B",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:3668850672,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD597_abab0df18a109da7bc92014eeda8069379cb9c9c704f8ffd3660e2e9a5e420df.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sims B", }, Group:"", SpecialGroup:"b_variant"},
+  3667867632: Warship{Name:"Massachusetts B",PriceGold:12750,Nation:"usa",IsPremium:true,ShipID:3667867632,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB598_6cb6911be640259ad4e0f8685a59a523200fc08831b2aa17ccc307b4cec6cee9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Massachusetts B", }, Group:"", SpecialGroup:"b_variant"},
+  3655251920: Warship{Name:"Gibraltar",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3655251920,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC610_cd1f54072fdeb83c7401c48a6dc551d9026e16458dffa9821fb3e3b7d9b9c793.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gibraltar", }, Group:"", SpecialGroup:""},
+  3741235184: Warship{Name:"Congress",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3741235184,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC528_cf8b57450f225648646fbefbf5f17c9913cd62a47f450a1d4905ef6fa12bd384.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Congress", }, Group:"", SpecialGroup:""},
+  3333371888: Warship{Name:"[Montana]",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:3333371888,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB917_b51c4665351b2b42f323ce938b73fc6b9f221f170bc0596eefbda0d437026597.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Montana]", }, Group:"", SpecialGroup:""},
+  3667801296: Warship{Name:"Loyang B",PriceGold:8000,Nation:"pan_asia",IsPremium:true,ShipID:3667801296,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD598_f64c264a3f18e944a69c430e0e9653c2534a407ab8a47f4d8fe4c4399443a6ef.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Loyang B", }, Group:"", SpecialGroup:"b_variant"},
+  3655284688: Warship{Name:"Incomparable",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3655284688,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB610_e616741f7ce382e7ca0141c7b2199cc53917bf65f7ae7dba1af08d84dc040889.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Incomparable", }, Group:"", SpecialGroup:""},
+  3667900112: Warship{Name:"Kaga B",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3667900112,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA598_1f079f5b90f8cb441fc748156b6893162a1fdb16a196b0e7e8907dfb89a5127b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kaga B", }, Group:"", SpecialGroup:"b_variant"},
+  3720263120: Warship{Name:"Bagration",PriceGold:11000,Nation:"ussr",IsPremium:false,ShipID:3720263120,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC548_ec91ff39387d75f665131e32295acb32aff713923462fb6f77a039e799a68a58.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bagration", }, Group:"", SpecialGroup:""},
+  3741267952: Warship{Name:"Alabama VL",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3741267952,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB528_c8538309aeb4d83da5d68e8a969c7604682ec90ab9bf485696e892fac1e813f1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alabama VL", }, Group:"", SpecialGroup:""},
+  3552524016: Warship{Name:"AL Littorio",PriceGold:12500,Nation:"italy",IsPremium:true,ShipID:3552524016,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB708_4cd7b7e3bb22eebf3749b02ba09cb7153cbd4e7a8fe75088d494d25e066fab46.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "AL Littorio", }, Group:"", SpecialGroup:"azur_lane"},
+  3743365072: Warship{Name:"Repulse",PriceGold:6500,Nation:"uk",IsPremium:false,ShipID:3743365072,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB526_73e67ba854a0377abf3dd17418b329299beee193f2cc80b78ff38b010e68106f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Repulse", }, Group:"", SpecialGroup:""},
+  3730782032: Warship{Name:"Flandre",PriceGold:12500,Nation:"france",IsPremium:true,ShipID:3730782032,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB538_88230ceef58767fc6917714f1b8a81c40adb455ed774303ad6e0543b2101c04d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Flandre", }, Group:"", SpecialGroup:""},
+  3552524272: Warship{Name:"Alabama ST",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3552524272,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB708_f1337d3f52a760c609ec07020ccbbb64619ade660243081590f4a119bfd0a945.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alabama ST", }, Group:"", SpecialGroup:""},
+  3666786288: Warship{Name:"Alaska B",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3666786288,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC599_95bd3329be3961b6dd53644ad00f6945308abbc7c3e086a0fc1259779c7a7d24.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alaska B", }, Group:"", SpecialGroup:"b_variant"},
+  3741235152: Warship{Name:"Belfast '43",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3741235152,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC528_b18d10456e0ce23c000d98c2bda042fe29b9cc157635bfd2e1a5bac2f6566abc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Belfast '43", }, Group:"", SpecialGroup:""},
+  3741300720: Warship{Name:"Saipan",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3741300720,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA528_916e92731e2b592523a82077d1c8c7124e1f7edc4ac7114b6cbc3fa3d60fbad6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Saipan", }, Group:"", SpecialGroup:""},
+  3551409616: Warship{Name:"Neustrashimy",PriceGold:19000,Nation:"ussr",IsPremium:true,ShipID:3551409616,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD709_83852f75688cca539a7861743f2d499361a558155b1cf5a1bc31af012a880cac.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Neustrashimy", }, Group:"", SpecialGroup:""},
+  3340645840: Warship{Name:"[Grozovoi]",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3340645840,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD910_feb6e779076ba64a93a2f295111228b4689bbc47d48c11eac2b31ef13e86b775.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "[Grozovoi]", }, Group:"", SpecialGroup:""},
+  3552458448: Warship{Name:"HSF Harekaze",PriceGold:9700,Nation:"japan",IsPremium:true,ShipID:3552458448,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD708_470f0fed4b35fe800a5801a477fe9f81a0d36b7e56741f57fb45241d4da0518b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "HSF Harekaze", }, Group:"", SpecialGroup:"hsf"},
+  3763287760: Warship{Name:"Ashitaka",PriceGold:9200,Nation:"japan",IsPremium:true,ShipID:3763287760,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB507_c640e33d5943ad652be03dcc03457a53312d3af4390c5cf39c1b0c3933ed9fc6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ashitaka", }, Group:"", SpecialGroup:""},
+  3761190096: Warship{Name:"Bajie",PriceGold:19000,Nation:"pan_asia",IsPremium:true,ShipID:3761190096,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSB509_38b8398d373355d52ba6028a33b409f7396e05cfd39abd3b3a644afb1cc79f4c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bajie", }, Group:"", SpecialGroup:""},
+  3750704336: Warship{Name:"Wujing",PriceGold:19000,Nation:"pan_asia",IsPremium:true,ShipID:3750704336,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSB519_17976c276ba2904f421dc630bfbca8dece736922178c4f89ea05ef753eff4d6a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wujing", }, Group:"", SpecialGroup:""},
+  3762205904: Warship{Name:"Irian",PriceGold:11500,Nation:"pan_asia",IsPremium:true,ShipID:3762205904,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC508_0605d18babad08c2fdacbee009d61c6492033ac5d47d6d71cd38c59cf4e26ce2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Irian", }, Group:"", SpecialGroup:""},
+  3760109008: Warship{Name:"Stalingrad",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3760109008,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC510_61b2d5bfa3e70ca626254c18e1ef0ebf005fa57b216926f053e9af60ebfa026b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Stalingrad", }, Group:"", SpecialGroup:""},
+  3763255280: Warship{Name:"Indianapolis",PriceGold:7500,Nation:"usa",IsPremium:true,ShipID:3763255280,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC507_c6e1a2b8f6831f2fd8363929e4345fc7621bfc19e02b5ea3d3bfea796ae25e64.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Indianapolis", }, Group:"", SpecialGroup:""},
+  3751720400: Warship{Name:"Lazo",PriceGold:8000,Nation:"ussr",IsPremium:true,ShipID:3751720400,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC518_94fad851de7fbb94d01ed1915ebc0b1287cd5e2d947aef61d51aa6085b50ebc1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lazo", }, Group:"", SpecialGroup:""},
+  3762173232: Warship{Name:"Orkan",PriceGold:10000,Nation:"europe",IsPremium:true,ShipID:3762173232,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD508_29af9a8fdb3ec4e0e20fe07c9042d3552aa6cef05efa8687efec540637ae80ac.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Orkan", }, Group:"", SpecialGroup:""},
+  3760076496: Warship{Name:"Hayate",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:3760076496,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD510_c9fdc6961cd1523d698ff6fd518db576de10b5fa2b859f4961f1aed667a6ffb5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hayate", }, Group:"", SpecialGroup:""},
+  3763222224: Warship{Name:"Yūdachi",PriceGold:6500,Nation:"japan",IsPremium:true,ShipID:3763222224,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD507_5561abb671cb8852d9b88cd3384dcbadee7d8af13e70adc2ddb4e9012c853996.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yūdachi", }, Group:"", SpecialGroup:""},
+  3761190608: Warship{Name:"Musashi",PriceGold:19200,Nation:"japan",IsPremium:true,ShipID:3761190608,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB509_51ef2df4e97193eec7ae82a5a4068f4a3618e7494343100c29966675264fc93e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Musashi", }, Group:"", SpecialGroup:""},
+  3763255248: Warship{Name:"Belfast",PriceGold:8200,Nation:"uk",IsPremium:true,ShipID:3763255248,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC507_2cfe2ef56e1d7f9aafd65abb3b42e2b5ba1f0527b0b0f7d62110fdb65c9484b4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Belfast", }, Group:"", SpecialGroup:""},
+  3760109520: Warship{Name:"Plymouth",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760109520,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC510_319a38828b4bc31d681f87287ba82dd0428c574a3024e000a199f8be14aeef6f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Plymouth", }, Group:"", SpecialGroup:""},
+  3762271440: Warship{Name:"Sanzang",PriceGold:11000,Nation:"pan_asia",IsPremium:true,ShipID:3762271440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSA508_05acb11904becdbcaca5ef3e6289f43c76b7725f674e8986181de0b2967eb580.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sanzang", }, Group:"", SpecialGroup:""},
+  3760174896: Warship{Name:"Werner Voss",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3760174896,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA510_edfc71a832b8e0f6ec275a6c3efef9dd6cec9a8a5614cd1a4686213a70f574a4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Werner Voss", }, Group:"", SpecialGroup:""},
+  3751753168: Warship{Name:"Lenin",PriceGold:11000,Nation:"ussr",IsPremium:true,ShipID:3751753168,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB518_03952270b37c005edd521e04e59bfd489c052a9ee68824716d01f158c71f72a9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lenin", }, Group:"", SpecialGroup:""},
+  3756963280: Warship{Name:"Varyag",PriceGold:2200,Nation:"ussr",IsPremium:true,ShipID:3756963280,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC513_4e3ce7c31b87f4d4f194cc1e9f65b8c47b61ff1a764baa25d296e0ebe36716cf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Varyag", }, Group:"", SpecialGroup:""},
+  3760109264: Warship{Name:"Azuma",PriceGold:0,Nation:"japan",IsPremium:true,ShipID:3760109264,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC510_886ef945c0c4bf7eb13c2bbe9fcec363931c50e65bd5bdbf7eda10fe2f8d94b3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Azuma", }, Group:"", SpecialGroup:""},
+  3762239184: Warship{Name:"Kii",PriceGold:12900,Nation:"japan",IsPremium:true,ShipID:3762239184,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB508_469caa40a5c2e0cf689eb4fbecd0505c0770622ad22c926a9ef66d97cba05931.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kii", }, Group:"", SpecialGroup:""},
+  3752736720: Warship{Name:"Cossack",PriceGold:9600,Nation:"uk",IsPremium:true,ShipID:3752736720,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD517_4dde170795a55472dfbb20b938e349c8acab41d7e4d25b09cd248b4653ca9ca6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cossack", }, Group:"", SpecialGroup:""},
+  3762271696: Warship{Name:"Chkalov",PriceGold:12000,Nation:"ussr",IsPremium:true,ShipID:3762271696,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSA508_d4b44193f09b7a33a4f16522c02b1fb56054274322047b5d8abb2a553a8413c9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chkalov", }, Group:"", SpecialGroup:""},
+  3752802000: Warship{Name:"Hyūga",PriceGold:9500,Nation:"japan",IsPremium:true,ShipID:3752802000,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB517_87846f80dce228cfae88a5e64d699e96f1ee0d9ae74898c5164bf6a7ced4c733.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hyūga", }, Group:"", SpecialGroup:""},
+  3750704848: Warship{Name:"Hizen",PriceGold:19000,Nation:"japan",IsPremium:true,ShipID:3750704848,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB519_4b90cfa605f55248d2988a152cf46b58265326bb6ab23703a5a9bcd0b7f5cec2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hizen", }, Group:"", SpecialGroup:""},
+  3761125136: Warship{Name:"Groningen",PriceGold:0,Nation:"netherlands",IsPremium:true,ShipID:3761125136,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSD509_ddeb7e5ec30944adf68ccc663a790f2b9b6de4e9cea0878da188cce906df712f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Groningen", }, Group:"", SpecialGroup:""},
+  3753818064: Warship{Name:"London",PriceGold:5500,Nation:"uk",IsPremium:true,ShipID:3753818064,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC516_bff72db6bcf79726ffe63e9ca1186e472ec8fdf5d6f212b988f4a49c7f07dba0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "London", }, Group:"", SpecialGroup:""},
+  3762206672: Warship{Name:"Cheshire",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3762206672,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC508_9a9fd5931be8a5a9b3928465c84494f02f5763f97332d9ee23a4782568664601.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cheshire", }, Group:"", SpecialGroup:""},
+  3753785136: Warship{Name:"Karl von Schönberg",PriceGold:5000,Nation:"germany",IsPremium:true,ShipID:3753785136,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD516_0b14a28a80a000b2abbca13888fc073c54fa76fe65c4132beed1c51c283bd02e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Karl von Schönberg", }, Group:"", SpecialGroup:""},
+  3751786448: Warship{Name:"Ark Royal",PriceGold:6000,Nation:"uk",IsPremium:true,ShipID:3751786448,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA518_06086d6aba70fc870f9d4b23ec25048b66d9cd60583fbf18b7b0334df8b1c120.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ark Royal", }, Group:"", SpecialGroup:""},
+  3753850320: Warship{Name:"Novorossiysk",PriceGold:6500,Nation:"ussr",IsPremium:true,ShipID:3753850320,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB516_c8685d4d60bf52e5ec002eb6b3ca57409be749115a820f947a100cbc22815082.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Novorossiysk", }, Group:"", SpecialGroup:""},
+  3751786192: Warship{Name:"Kaga",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:3751786192,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA518_12ef9eaa534e14ae966d5c5af03277e8c9142ae157cbdf76b4a49afe9fc7910f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kaga", }, Group:"", SpecialGroup:""},
+  3763288016: Warship{Name:"Hood",PriceGold:9700,Nation:"uk",IsPremium:true,ShipID:3763288016,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB507_e098ab5ebbaa15a51f4478426756ae0a6f54100e8fdb3046b3a128415d341e68.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hood", }, Group:"", SpecialGroup:""},
+  3761157936: Warship{Name:"Siegfried",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3761157936,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC509_7e689795bb3c18aea53df216bfc6b284bb508e065f1fb73bfdb16ef982a50aa7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Siegfried", }, Group:"", SpecialGroup:""},
+  3762173744: Warship{Name:"Z-39",PriceGold:5900,Nation:"germany",IsPremium:true,ShipID:3762173744,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD508_9aa92d1aab51689cf337177e30b27688c614bb9803dc617aacbf89f0ed4a0fa4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-39", }, Group:"", SpecialGroup:""},
+  3760076144: Warship{Name:"Vampire II",PriceGold:34650,Nation:"commonwealth",IsPremium:false,ShipID:3760076144,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSD510_901a44336ad4c7b7ff51b70760f6216b5990213143294dbae076051f923403c4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vampire II", }, Group:"", SpecialGroup:""},
+  3762272208: Warship{Name:"Indomitable",PriceGold:12000,Nation:"uk",IsPremium:true,ShipID:3762272208,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA508_6e97d7ad73519f2413f536e8f0acf2526eeb1a9d11e2bbc857e4a35f9ff595f6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Indomitable", }, Group:"", SpecialGroup:""},
+  3761190704: Warship{Name:"Pommern",PriceGold:19000,Nation:"germany",IsPremium:true,ShipID:3761190704,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB509_d7b48615708d19f87a1bad422ce04357216e3f8f486e82ee0f843ea55c385dc8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pommern", }, Group:"", SpecialGroup:""},
+  3761157584: Warship{Name:"Kronshtadt",PriceGold:19200,Nation:"ussr",IsPremium:true,ShipID:3761157584,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC509_fb3152ccffe21b181bf0cc0ab39207e05c1e28d24f95653ec92bc03b5319d99a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kronshtadt", }, Group:"", SpecialGroup:""},
+  3750704880: Warship{Name:"Giuseppe Verdi",PriceGold:19000,Nation:"italy",IsPremium:false,ShipID:3750704880,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB519_621ccb21d8a7ddeec787bbb4bd5e121952ca8b90c7c737b33ba1f2ecdd16e3e5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Giuseppe Verdi", }, Group:"", SpecialGroup:""},
+  3761190896: Warship{Name:"Missouri",PriceGold:19200,Nation:"usa",IsPremium:true,ShipID:3761190896,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB509_9b435c1100a6f5b7c3c188bffb12ee3243cd9394b7df9bf9dced5c8a003d0dd0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Missouri", }, Group:"", SpecialGroup:""},
+  3751786288: Warship{Name:"Graf Zeppelin",PriceGold:13700,Nation:"germany",IsPremium:true,ShipID:3751786288,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA518_3f1aff5cb151b143cc14e9da364ab2d3269cd9a6ab80df0529ccd0e1b75c1ba4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Graf Zeppelin", }, Group:"", SpecialGroup:""},
+  3760142032: Warship{Name:"Shikishima",PriceGold:34650,Nation:"japan",IsPremium:false,ShipID:3760142032,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB510_b46a68a145c607e6f49c4cd5f70a42903cc4f094b63ee00a78f9429c0e36eb15.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shikishima", }, Group:"", SpecialGroup:""},
+  3753817456: Warship{Name:"Mysore",PriceGold:5500,Nation:"commonwealth",IsPremium:true,ShipID:3753817456,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSC516_fb49e464919cfbabc01558462d20b8823cfd5d96359e6cb8299e03d3a4f24480.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mysore", }, Group:"", SpecialGroup:""},
+  3762206160: Warship{Name:"Mikhail Kutuzov",PriceGold:10250,Nation:"ussr",IsPremium:true,ShipID:3762206160,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC508_e047dfbb9700ac15ac06b7217523b7a6d34a9b5fba7d3829ebe4a4ea1c507d1f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mikhail Kutuzov", }, Group:"", SpecialGroup:""},
+  3761125104: Warship{Name:"Paolo Emilio",PriceGold:19000,Nation:"italy",IsPremium:true,ShipID:3761125104,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISD509_a6d5e882132c524a99fd3344da9034896ef74f9b892cf77394c05493ad9f4576.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Paolo Emilio", }, Group:"", SpecialGroup:""},
+  3760076752: Warship{Name:"Druid",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760076752,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD510_e01534cb91817384e64f34dee6cedc7bedb413d86ffdea68512298d6f0fd02ef.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Druid", }, Group:"", SpecialGroup:""},
+  3762239472: Warship{Name:"Alabama",PriceGold:12200,Nation:"usa",IsPremium:true,ShipID:3762239472,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB508_69f709e9b4f7f819cdbebaee0817f988d5d2afc87de49d4cc79146b40d0e87f4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alabama", }, Group:"", SpecialGroup:""},
+  3761157968: Warship{Name:"Carnot",PriceGold:19000,Nation:"france",IsPremium:true,ShipID:3761157968,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC509_75e67dc62eb151974ea01203994258387edca1efeda9ba90752bdd161999aecf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Carnot", }, Group:"", SpecialGroup:""},
+  3761190864: Warship{Name:"Marlborough",PriceGold:19000,Nation:"uk",IsPremium:false,ShipID:3761190864,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB509_6c87b8cae0e81d88127df018a4f457e1364ebb01cfeae6f22c95d4484ff8cf54.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Marlborough", }, Group:"", SpecialGroup:""},
+  3761190640: Warship{Name:"Marco Polo",PriceGold:19000,Nation:"italy",IsPremium:true,ShipID:3761190640,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB509_4017aa95a0367c67c211cc2c24575a47dd617cd4510f9f8ba254155366ccfdd8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Marco Polo", }, Group:"", SpecialGroup:""},
+  3763254608: Warship{Name:"Nueve de Julio",PriceGold:8500,Nation:"pan_america",IsPremium:true,ShipID:3763254608,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PVSC507_823e3d410006ac4bf8c793d3f0675c60b9408bf146a78bba2934cdb6864307e5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nueve de Julio", }, Group:"", SpecialGroup:""},
+  3760109296: Warship{Name:"Napoli",PriceGold:34650,Nation:"italy",IsPremium:false,ShipID:3760109296,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC510_d2a5839fbf073d26b7e5c8a6c04ae11c5dc3e107dbffe45d19ea32cbfe30ab36.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Napoli", }, Group:"", SpecialGroup:""},
+  3763288048: Warship{Name:"W. Virginia 1941",PriceGold:7000,Nation:"usa",IsPremium:true,ShipID:3763288048,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB507_06ebdd3d8e3613cb57a419708ae5f1e02a977f58b60f8956ae40012fe1f95c72.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "W. Virginia 1941", }, Group:"", SpecialGroup:""},
+  3762173936: Warship{Name:"Kidd",PriceGold:9100,Nation:"usa",IsPremium:true,ShipID:3762173936,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD508_932f023f70ccd57b093e1c765dacffeb8a73549842f2775db64622a9cb8f0eb6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kidd", }, Group:"", SpecialGroup:""},
+  3762206480: Warship{Name:"De Zeven Provinciën",PriceGold:11000,Nation:"netherlands",IsPremium:true,ShipID:3762206480,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC508_f05c8bdb50e0b3de0728f1f0f50a2fbd7b621d0c5fb25d994bef3e77be135edd.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "De Zeven Provinciën", }, Group:"", SpecialGroup:""},
+  3762239216: Warship{Name:"Roma",PriceGold:13100,Nation:"italy",IsPremium:true,ShipID:3762239216,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB508_53c45c0e3ec38f1b909069d9b7402909b3551ff9d0a40da8f199beb1450c23e3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Roma", }, Group:"", SpecialGroup:""},
+  3762173776: Warship{Name:"Le Terrible",PriceGold:9500,Nation:"france",IsPremium:true,ShipID:3762173776,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD508_03e6130483cab70e7b7834dbe38b53defa3ee0593eedb8444fb75ee6fa62f2ac.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Le Terrible", }, Group:"", SpecialGroup:""},
+  3752769328: Warship{Name:"Weimar",PriceGold:8500,Nation:"germany",IsPremium:true,ShipID:3752769328,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC517_5180a5a07408056ff2e32fbc7077fff58aa50482679d8b88c8ff8a0e2be69981.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Weimar", }, Group:"", SpecialGroup:""},
+  3751720944: Warship{Name:"Anchorage",PriceGold:11000,Nation:"usa",IsPremium:true,ShipID:3751720944,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC518_2c04adeedc12c9b2877e5570c4292fc3bb950101c4728313b6650bcd191b9aca.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Anchorage", }, Group:"", SpecialGroup:""},
+  3751720912: Warship{Name:"Tiger '59",PriceGold:11000,Nation:"uk",IsPremium:true,ShipID:3751720912,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC518_53a67085699bc04c0a95991ef25af319a4c6065d025cc7f0be8c10a8d3f463ca.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tiger '59", }, Group:"", SpecialGroup:""},
+  3762206512: Warship{Name:"Prinz Eugen",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3762206512,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC508_f1aeb94a477976b1dc3a487804f06ef16688b53c9c3b6a796007e2f439815193.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Prinz Eugen", }, Group:"", SpecialGroup:""},
+  3760141776: Warship{Name:"Slava",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:3760141776,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB510_26c203a53c970d54315d80ad4795e622d039a83c4b4544a34b7248e8c08d0ca2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Slava", }, Group:"", SpecialGroup:""},
+  3760175088: Warship{Name:"Franklin D. Roosevelt",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760175088,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA510_753bec438062c97bec4edbf3bb532fd07ddcb927f8e5c81bb1cc9d5733a3a601.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Franklin D. Roosevelt", }, Group:"", SpecialGroup:""},
+  3760142320: Warship{Name:"Ohio",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760142320,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB510_0a7e63d55f4ff6617f5a8f08a6e71fdf338a0503b0275829f3ce94d9e36333f2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ohio", }, Group:"", SpecialGroup:""},
+  3762206704: Warship{Name:"Wichita",PriceGold:11500,Nation:"usa",IsPremium:true,ShipID:3762206704,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC508_8cf65982bb9fa3c9dfe532a2d933b9c6df2149dab6f9d91d53d87753d0982b81.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wichita", }, Group:"", SpecialGroup:""},
+  3751687984: Warship{Name:"Z-35",PriceGold:10000,Nation:"germany",IsPremium:true,ShipID:3751687984,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD518_679ed930bc2d1e7b63ab2e5a8ad9c73f5a542fcc92206e0a7e6be21a5241506f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-35", }, Group:"", SpecialGroup:""},
+  3763255088: Warship{Name:"München",PriceGold:8500,Nation:"germany",IsPremium:true,ShipID:3763255088,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC507_d0f492786caa3e686588d9b703af8947803c3c8f7f019169324c05a129409e5e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "München", }, Group:"", SpecialGroup:""},
+  3760109392: Warship{Name:"Colbert",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:3760109392,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC510_10eef852f24547736bc76afb32db05fa0920d2373a600f9cebdc23eb6cc419e7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Colbert", }, Group:"", SpecialGroup:""},
+  3760076080: Warship{Name:"Friesland",PriceGold:19000,Nation:"europe",IsPremium:true,ShipID:3760076080,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD510_fa46df265e3a795777c63a7d860174b347d2a326a3fb6e11e6c9e1587fdabdee.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Friesland", }, Group:"", SpecialGroup:""},
+  3762206544: Warship{Name:"Bayard",PriceGold:11000,Nation:"france",IsPremium:true,ShipID:3762206544,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC508_8db686e4ca232f3275cb158af16e75911a767390d29ea43667f9b03d1cd44dc0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bayard", }, Group:"", SpecialGroup:""},
+  3751753520: Warship{Name:"Brandenburg",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3751753520,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB518_c5f6a3be03f0b3a7cacc104c428bd36b4a1b7383aae090ad3864bc023a546642.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Brandenburg", }, Group:"", SpecialGroup:""},
+  3760076784: Warship{Name:"Somers",PriceGold:34650,Nation:"usa",IsPremium:false,ShipID:3760076784,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD510_7b5437536ccc5663e008883c4f0bf1bcb14352db9769d37d7340ca31d95e6d15.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Somers", }, Group:"", SpecialGroup:""},
+  3760142160: Warship{Name:"Bourgogne",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:3760142160,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB510_2ec82c44ef680c0cf483f973bdc8fedbb4157e8500eea1e7df4889b3a7bc7781.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bourgogne", }, Group:"", SpecialGroup:""},
+  3751786480: Warship{Name:"Enterprise",PriceGold:14000,Nation:"usa",IsPremium:true,ShipID:3751786480,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA518_1d6166593fe612e7436a4cd708d938a973cee0a65c37c5c7479fd817644b8626.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Enterprise", }, Group:"", SpecialGroup:""},
+  3751753552: Warship{Name:"Jean Bart",PriceGold:19200,Nation:"france",IsPremium:true,ShipID:3751753552,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB518_e98a1b58fe899c6c0f69cc9973ed0e55c42e68de1eee1fc58ef2513f9fa36da3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jean Bart", }, Group:"", SpecialGroup:""},
+  3762173136: Warship{Name:"Loyang",PriceGold:8000,Nation:"pan_asia",IsPremium:true,ShipID:3762173136,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD508_e24bc8a7bc321dd877414d2223334d7037572eea68655535bf05a977e8608eb0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Loyang", }, Group:"", SpecialGroup:""},
+  3763221872: Warship{Name:"Haida",PriceGold:5600,Nation:"commonwealth",IsPremium:true,ShipID:3763221872,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSD507_001ecb2e9c8eafa05dd9aca63569dc50e4cb1f5a2dbb130d2bdfd5ad1155cbee.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Haida", }, Group:"", SpecialGroup:""},
+  3762238928: Warship{Name:"Poltava",PriceGold:9500,Nation:"ussr",IsPremium:true,ShipID:3762238928,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB508_57dadd4de8c446cd9d37bc493a81c2dde547e777e454ea156f1366adf4825834.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Poltava", }, Group:"", SpecialGroup:""},
+  3761125360: Warship{Name:"Benham",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3761125360,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD509_416a92f6023fe6d209316177e1c5b28d27b28c069d66becf28ea59942081a255.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Benham", }, Group:"", SpecialGroup:""},
+  3760109552: Warship{Name:"Alaska",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3760109552,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC510_1c368c094c16e8fc1fed07afbc237cb7fde0cf956ce171dc419eabfbaedf4364.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alaska", }, Group:"", SpecialGroup:""},
+  3751753712: Warship{Name:"Massachusetts",PriceGold:12750,Nation:"usa",IsPremium:true,ShipID:3751753712,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB518_2f9d62bd58e089b4190828ea78dd173b0c85959dac242ce1ea0c192c5fc9b8fe.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Massachusetts", }, Group:"", SpecialGroup:""},
+  3762239312: Warship{Name:"Gascogne",PriceGold:12400,Nation:"france",IsPremium:true,ShipID:3762239312,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB508_9dae06fe7e9eae2f1b88123fb8e9dd08a219fb9c43e8d60519a338501dc894f8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gascogne", }, Group:"", SpecialGroup:""},
+  3761157328: Warship{Name:"Dalian",PriceGold:19000,Nation:"pan_asia",IsPremium:false,ShipID:3761157328,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC509_7ee92df4e82319bad3c92a99c184dbbcde5bc7b36eac32a05d755eb43e7d34f9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dalian", }, Group:"", SpecialGroup:""},
+  3751687888: Warship{Name:"Asashio",PriceGold:9300,Nation:"japan",IsPremium:true,ShipID:3751687888,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD518_31adcca978dfffcaaceee151db4a83095a69aaf99d8b5ec0f503709480c5eeae.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Asashio", }, Group:"", SpecialGroup:""},
+  3752802288: Warship{Name:"Florida",PriceGold:9500,Nation:"usa",IsPremium:true,ShipID:3752802288,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB517_b904d4dfac13920e69f4ebce1c154df4d7e15c05300e82fdc606b7f24ad4c666.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Florida", }, Group:"", SpecialGroup:""},
+  3751687376: Warship{Name:"Fenyang",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:3751687376,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD518_e8c31988eb047869dc1f5d63472625c9a610631354a073631a580ce1eb722c17.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fenyang", }, Group:"", SpecialGroup:""},
+  3750672176: Warship{Name:"Ägir",PriceGold:0,Nation:"germany",IsPremium:true,ShipID:3750672176,PriceCredit:1,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC519_cde14774f453d72c93ec39ca91d053ce36969ad53d6e9aa09a0df19cbb322b14.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ägir", }, Group:"", SpecialGroup:""},
+  3762239280: Warship{Name:"Odin",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:3762239280,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB508_83d1664ae9eef542099dbef64f77537be011b0da14d76189b3c4dbd01e14dec7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Odin", }, Group:"", SpecialGroup:""},
+  3762239440: Warship{Name:"Vanguard",PriceGold:13000,Nation:"uk",IsPremium:true,ShipID:3762239440,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB508_b605ebb9aad4f08ef0e7aa42da0379d18ead80e94458146efb8dc5b0a4fb4af7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vanguard", }, Group:"", SpecialGroup:""},
+  3763255024: Warship{Name:"Duca degli Abruzzi",PriceGold:8600,Nation:"italy",IsPremium:true,ShipID:3763255024,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC507_c087484aa63c5c8cb5351c06b2afbff94a8c7a90a26a381b4c48b316d600f105.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Duca degli Abruzzi", }, Group:"", SpecialGroup:""},
+  3763287888: Warship{Name:"Strasbourg",PriceGold:9500,Nation:"france",IsPremium:true,ShipID:3763287888,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB507_d85d28897056f6d452f6bab7bc82a1d5dafce87ef3eded027465599f386fbe5b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Strasbourg", }, Group:"", SpecialGroup:""},
+  3763287408: Warship{Name:"Yukon",PriceGold:9500,Nation:"commonwealth",IsPremium:true,ShipID:3763287408,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSB507_a872c60bef17ffbd6321c6c1f6cbce51944c4aea844d010c75966a4fe46a074c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yukon", }, Group:"", SpecialGroup:""},
+  3750672368: Warship{Name:"Tulsa",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3750672368,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC519_823cdde2d22cf5062d33b79105bdaf17bd4822f1baf017c2047b2471fa674bf3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tulsa", }, Group:"", SpecialGroup:""},
+  3752802256: Warship{Name:"Nelson",PriceGold:9500,Nation:"uk",IsPremium:true,ShipID:3752802256,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB517_9a17345d760bb6f7e1ac44f67f04e412098b784b8d9fbcebfd0713d6b1d21d65.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nelson", }, Group:"", SpecialGroup:""},
+  3751720144: Warship{Name:"Wukong",PriceGold:11000,Nation:"pan_asia",IsPremium:true,ShipID:3751720144,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC518_69cb25daab8ba07a50ac12a8c294e23e1027094bffbf1b5c9192b794e528501f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wukong", }, Group:"", SpecialGroup:""},
+  3751720752: Warship{Name:"Mainz",PriceGold:11000,Nation:"germany",IsPremium:true,ShipID:3751720752,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC518_51a77b095f1a3be3c3ffa5851e974a84d82cb59c7b904974994398d59e7ead94.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mainz", }, Group:"", SpecialGroup:""},
+  3763287856: Warship{Name:"Scharnhorst",PriceGold:9500,Nation:"germany",IsPremium:true,ShipID:3763287856,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB507_f73355091fc84cf4b698a3b9b6f0e60c14091a22503afe00b9533916f969533d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Scharnhorst", }, Group:"", SpecialGroup:""},
+  3760142288: Warship{Name:"Thunderer",PriceGold:34650,Nation:"uk",IsPremium:false,ShipID:3760142288,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB510_21d69bbf542aa82e43e01a5ba3a31784a4df583d20daa805a8c077e625c17309.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Thunderer", }, Group:"", SpecialGroup:""},
+  3750705136: Warship{Name:"Kearsarge",PriceGold:19000,Nation:"usa",IsPremium:true,ShipID:3750705136,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB519_738e2e142c001aec261b31c0865498e13bf5e750bf8fd0771239501ce6c73e26.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kearsarge", }, Group:"", SpecialGroup:""},
+  3763221968: Warship{Name:"Leningrad",PriceGold:5250,Nation:"ussr",IsPremium:true,ShipID:3763221968,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD507_8808c7278f56399687c91a005933056f8312d078503f2c6b6bcb7bba732e8c88.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Leningrad", }, Group:"", SpecialGroup:""},
+  3754866128: Warship{Name:"Mikoyan",PriceGold:4000,Nation:"ussr",IsPremium:true,ShipID:3754866128,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC515_1292d736e1c0c9671ad5a8ed3da2267cd3fd273d66044c6a5c5572542557f3b6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mikoyan", }, Group:"", SpecialGroup:""},
+  4078876656: Warship{Name:"Dallas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4078876656,PriceCredit:3100000,Tier:6,NextShips:map[string]int64{ "4077828080": 75000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC206_758062c1b1a7c400b03bc84734f371e4faf479d9837379e2433408f1036a192c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dallas", }, Group:"", SpecialGroup:""},
+  3764271056: Warship{Name:"Gallant",PriceGold:4500,Nation:"uk",IsPremium:true,ShipID:3764271056,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD506_e25a4de7802d06c90b5facc85ff7d753d3b43badc6a93fce41327562ee54d8e3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gallant", }, Group:"", SpecialGroup:""},
+  4076746544: Warship{Name:"Gustav-Julius Maerker",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4076746544,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4075697968": 165000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD208_153fc58fd3b753461c7ae937c41c13fbbf64ed74c5373f7f044097f0125b8554.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gustav-Julius Maerker", }, Group:"", SpecialGroup:""},
+  3764303056: Warship{Name:"Huanghe",PriceGold:5400,Nation:"pan_asia",IsPremium:true,ShipID:3764303056,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC506_b25a8e7a3e744f47f3ae216378f3dc038883737dd91cadb0f22a741668651a54.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Huanghe", }, Group:"", SpecialGroup:""},
+  4075730384: Warship{Name:"Riga",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4075730384,PriceCredit:13800000,Tier:9,NextShips:map[string]int64{ "3969824208": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC209_0ae9b8bdf3da437c1eec68dbdc3832fbf8e63954c2b78cdd07e593276d580404.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Riga", }, Group:"", SpecialGroup:""},
+  4076778960: Warship{Name:"Tallinn",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4076778960,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4075730384": 165000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC208_c31f5aac96932fe10c45e4a42b25cf7d7c29e280b629fac429619b9f0eb1ccd1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tallinn", }, Group:"", SpecialGroup:""},
+  4079891920: Warship{Name:"Podvoisky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4079891920,PriceCredit:1400000,Tier:5,NextShips:map[string]int64{ "4078843344": 34500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD205_63f952a1ba5adfb2e7bf7ddbe286f0c078b4d81bb4f56f98a1e40fcc12facecf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Podvoisky", }, Group:"", SpecialGroup:""},
+  4074681808: Warship{Name:"Alexander Nevsky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4074681808,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC210_cab7f8a2b43c3566b7bbd460fd3d280f1e826f3b3f2af529da06cca5e675ca04.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alexander Nevsky", }, Group:"", SpecialGroup:""},
+  4179506480: Warship{Name:"Halland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4179506480,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD110_f62fe24ced8906191ddfdff91730e3a9ac2daf46b63205d3738bcee9a1e0096c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Halland", }, Group:"", SpecialGroup:""},
+  4077795024: Warship{Name:"Shiratsuyu",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4077795024,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181604048": 115000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD207_0353f8a63a02490d991b25e39665419f3840d9e16bdb3e35fa8fb0e5eb6c6c78.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shiratsuyu", }, Group:"", SpecialGroup:""},
+  4065212112: Warship{Name:"Kitakaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4065212112,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4074649296": 223000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD219_ec616c3e3fb1a52df1ce2fceb69b42b319b21348217973e52fb703914ade1e99.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kitakaze", }, Group:"", SpecialGroup:""},
+  4179507024: Warship{Name:"Kléber",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179507024,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD110_7b799ee4fbf52845fc6acd2628898d6429ccb35bccc4bb37a9e1374bda25cac9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kléber", }, Group:"", SpecialGroup:""},
+  4074747856: Warship{Name:"Audacious",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4074747856,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA210_f5d7acb803490a2cf8ca59704d483f3b61f0e5a58ecd19e30ae1b882e200ba4d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Audacious", }, Group:"", SpecialGroup:""},
+  4078876624: Warship{Name:"Devonshire",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4078876624,PriceCredit:3300000,Tier:6,NextShips:map[string]int64{ "4077828048": 72000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC206_89d3bdbfd65d9c6fb3f1b2da50e916ac939a6cfa6569b9b4e429023f526b0b65.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Devonshire", }, Group:"", SpecialGroup:""},
+  4077795120: Warship{Name:"Z-31",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4077795120,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4076746544": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD207_64cb0dedb53d4bc556c935a8f39d8432af3a514041d3df437a8378f45fc66b33.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-31", }, Group:"", SpecialGroup:""},
+  3765384656: Warship{Name:"Oktyabrskaya Revolutsiya",PriceGold:5050,Nation:"ussr",IsPremium:true,ShipID:3765384656,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB505_6688f90b290439fdff02ff8dc734d98e36ef1f21ae1d04a9c1d7095970334e43.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Oktyabrskaya Revolutsiya", }, Group:"", SpecialGroup:""},
+  3765352144: Warship{Name:"Yahagi",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:3765352144,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC505_f3444e89f551cae2620d8d24f4a80ff0b1713e631936fca70bb8fe01d57db7de.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yahagi", }, Group:"", SpecialGroup:""},
+  4074649296: Warship{Name:"Harugumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4074649296,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD210_862f91814ff6ab4143a329542f73b93b5e4a4beefd9958a9585b8d1c1a85337b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Harugumo", }, Group:"", SpecialGroup:""},
+  3767449296: Warship{Name:"Katori",PriceGold:2250,Nation:"japan",IsPremium:true,ShipID:3767449296,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC503_b03d3cbd04e63c4db90dfaf7eb16af76246530db3af68d6cacc61cc5ef983914.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Katori", }, Group:"", SpecialGroup:""},
+  3764336432: Warship{Name:"Prinz Eitel Friedrich",PriceGold:6800,Nation:"germany",IsPremium:true,ShipID:3764336432,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB506_d8b0ba60b21183cd8af7d0071bdca57687cf1890e822219a828cabb59572e0a8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Prinz Eitel Friedrich", }, Group:"", SpecialGroup:""},
+  4079925200: Warship{Name:"Hawkins",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4079925200,PriceCredit:1400000,Tier:5,NextShips:map[string]int64{ "4078876624": 35000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC205_7677c337a416e9d241555551e292b9140a50d320c06423484b4e359ba9317adf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hawkins", }, Group:"", SpecialGroup:""},
+  4179506992: Warship{Name:"Z-52",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179506992,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD110_9afa6ea3980695c40159f5b4b290b7caff8f3150db86fde1a5142866d7b77748.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-52", }, Group:"", SpecialGroup:""},
+  3766433072: Warship{Name:"Viribus Unitis",PriceGold:5000,Nation:"europe",IsPremium:true,ShipID:3766433072,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSB504_b4e0958684338f02d0a670cbb28413b8b383e38cefdbeb71f7134d15a12518cb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Viribus Unitis", }, Group:"", SpecialGroup:""},
+  3764336336: Warship{Name:"Mutsu",PriceGold:6550,Nation:"japan",IsPremium:true,ShipID:3764336336,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB506_f7afee3eeaab2267520c0e70725e9d89ea6acf2b9507b7116620abb1d518f0af.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mutsu", }, Group:"", SpecialGroup:""},
+  3768465392: Warship{Name:"Smith",PriceGold:1050,Nation:"usa",IsPremium:true,ShipID:3768465392,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD502_20541d13622858429f4cd9b120288fdde32606c103463e78d1c7e3c5d3d4c3e5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Smith", }, Group:"", SpecialGroup:""},
+  4077828048: Warship{Name:"Surrey",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4077828048,PriceCredit:5650000,Tier:7,NextShips:map[string]int64{ "4076779472": 112000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC207_72e01a8bc28f14335409d5e0e09450db6db6675130fbb110e871639ef3e2907d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Surrey", }, Group:"", SpecialGroup:""},
+  4077794768: Warship{Name:"Minsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4077794768,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "3971888592": 109000,"4076746192": 105000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD207_f11b7abace4c54b871cd6e891afe44231503bbe7206dc1ea4d220165d903ae03.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Minsk", }, Group:"", SpecialGroup:""},
+  3767416784: Warship{Name:"Campbeltown",PriceGold:1650,Nation:"uk",IsPremium:true,ShipID:3767416784,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD503_796c17133979f3dba72e1d0d842eef1790cdce61172140a40d0f993ba2a97834.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Campbeltown", }, Group:"", SpecialGroup:""},
+  4074649392: Warship{Name:"Elbing",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4074649392,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD210_c4929c97f9b8f6cecb9b9ac51b186e9a4fd4fa3017ed2fad5b556663db93d26f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Elbing", }, Group:"", SpecialGroup:""},
+  3864933840: Warship{Name:"Delny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3864933840,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD410_434c969555758c5e576421a72de10177ddf660fd4b3d6579da96a92187e8e1a6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Delny", }, Group:"", SpecialGroup:""},
+  4076746448: Warship{Name:"Kagerō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4076746448,PriceCredit:9100000,Tier:8,NextShips:map[string]int64{ "4075697872": 154000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD208_878edebc5f022d83d994a8d75ef49b6de18814267ccc0c4a13d4cbcca9143c1e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kagerō", }, Group:"", SpecialGroup:""},
+  4069438928: Warship{Name:"Kotovsky",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4069438928,PriceCredit:1280000,Tier:5,NextShips:map[string]int64{ "4183733712": 34000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC215_c0fbe6f6e8ff576444ee7d1e5548c786416babee6e7ee8191cc9382e72f3ff77.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kotovsky", }, Group:"", SpecialGroup:""},
+  3765385168: Warship{Name:"Agincourt",PriceGold:5000,Nation:"uk",IsPremium:true,ShipID:3765385168,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB505_649187e67cb463391f6efec5cff033ee213b6895364979a2889feac033698198.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Agincourt", }, Group:"", SpecialGroup:""},
+  3768497968: Warship{Name:"Emden",PriceGold:1500,Nation:"germany",IsPremium:true,ShipID:3768497968,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC502_0368049f8d9c4c52a7d92148e41e8a832b63a25fffe3fc9a363790cd69c22040.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Emden", }, Group:"", SpecialGroup:""},
+  3865982416: Warship{Name:"Tashkent",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3865982416,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4179506640": 226000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD409_43fe2b0655c4eb04a9f25f0f8084053d7a6d589f3cdf0f995b30ddc8861b5a3d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tashkent", }, Group:"", SpecialGroup:""},
+  4178458320: Warship{Name:"Yamagiri",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4178458320,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD111_c4ea583b76eee7e016f86d6df2557224b018537c39cee96eef4bed63fd472f5d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yamagiri", }, Group:"", SpecialGroup:""},
+  3764303216: Warship{Name:"Perth",PriceGold:4800,Nation:"commonwealth",IsPremium:true,ShipID:3764303216,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSC506_54f91c7a238b88fb88417e4ad3671f34f61be755a1e100363acb661b199a63d4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Perth", }, Group:"", SpecialGroup:""},
+  4081039312: Warship{Name:"Hermes",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4081039312,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799760": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA204_e1a8ef7f1d74f1fb6898a4752abe79ba82f5a53f36d6f2cbd69828016541c45c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hermes", }, Group:"", SpecialGroup:""},
+  4178491216: Warship{Name:"Condé",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4178491216,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC111_5be16e90996aefab995402778245f388847629f0f03a51ed1433d2087337e234.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Condé", }, Group:"", SpecialGroup:""},
+  3767482160: Warship{Name:"König Albert",PriceGold:2200,Nation:"germany",IsPremium:true,ShipID:3767482160,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB503_b54f41d5fb3a82619bdcb8c655c378f41452c858b3ea124ea610ab857ad3f3cf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "König Albert", }, Group:"", SpecialGroup:""},
+  4178523952: Warship{Name:"Hannover",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4178523952,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB111_5e10fd46931fe5e04258539034bbec81ef0257cb0fa6431b0f741e16c4fe76a2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hannover", }, Group:"", SpecialGroup:""},
+  4078843600: Warship{Name:"Hatsuharu",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4078843600,PriceCredit:2950000,Tier:6,NextShips:map[string]int64{ "4077795024": 60000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD206_e5506189b8c14dd20516649a3050d0d1f4f1cb07eaff7e2ae52840fbb4191138.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hatsuharu", }, Group:"", SpecialGroup:""},
+  3765352400: Warship{Name:"Exeter",PriceGold:4000,Nation:"uk",IsPremium:true,ShipID:3765352400,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC505_475aaceca4d71ac52e7734487b8975108ecdcd354f97a28187d8e2a7afb191ce.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Exeter", }, Group:"", SpecialGroup:""},
+  3764369200: Warship{Name:"Erich Loewenhardt",PriceGold:6000,Nation:"germany",IsPremium:true,ShipID:3764369200,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA506_9aeb5484a2d385b989f8a74b448f766be7d8201569ed07e0693f84ba7dac4e37.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Erich Loewenhardt", }, Group:"", SpecialGroup:""},
+  3764270896: Warship{Name:"T-61",PriceGold:5400,Nation:"germany",IsPremium:true,ShipID:3764270896,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD506_7dcc64e840bc4ff641d4669aae606aacf023f41a222cfb0b71bcfaf4c692d865.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "T-61", }, Group:"", SpecialGroup:""},
+  4075697872: Warship{Name:"Yūgumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4075697872,PriceCredit:13200000,Tier:9,NextShips:map[string]int64{ "4282267344": 223000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD209_29f2671a92e1ccfcc9943ff46985e373b28a6be6be8381ecd46f3f5e00f2dca7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yūgumo", }, Group:"", SpecialGroup:""},
+  3767482320: Warship{Name:"Dreadnought",PriceGold:3500,Nation:"uk",IsPremium:true,ShipID:3767482320,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB503_f295a50d0bfe59256c0c8e9a704b0c159cb53f56c4bcfca3b3eba4630541a1e1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dreadnought", }, Group:"", SpecialGroup:""},
+  4076745936: Warship{Name:"Siliwangi",PriceGold:10000,Nation:"pan_asia",IsPremium:true,ShipID:4076745936,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD208_42971ab64065dfa91b9cd85d8343fb4afa9051ff8eefc46fb88e154af6453b88.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Siliwangi", }, Group:"", SpecialGroup:""},
+  3764303600: Warship{Name:"Duca d'Aosta",PriceGold:4700,Nation:"italy",IsPremium:true,ShipID:3764303600,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC506_8f54e2f7c9084821c2f84bb70dd6191a3fb5afcfe2b3b8becab9546ad4b114e7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Duca d'Aosta", }, Group:"", SpecialGroup:""},
+  4178491376: Warship{Name:"Annapolis",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4178491376,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC111_edf8923043fdc578f24073c792ea45afcd06bc7c22e987c663a1d528260df989.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Annapolis", }, Group:"", SpecialGroup:""},
+  3765352176: Warship{Name:"Genova",PriceGold:4000,Nation:"italy",IsPremium:true,ShipID:3765352176,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC505_b0aa40be7249d6fce67e764d450abf4f9739d5254168938abe32349ff49251e8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Genova", }, Group:"", SpecialGroup:""},
+  3764271088: Warship{Name:"Monaghan",PriceGold:4600,Nation:"usa",IsPremium:true,ShipID:3764271088,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD506_cccf826bd401d17d94eb5e3707e49ebdc90819b7a55864f8d20cb41cf0cf9036.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Monaghan", }, Group:"", SpecialGroup:""},
+  4074714928: Warship{Name:"Schlieffen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4074714928,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB210_915ab09f3ca37d6fb99e15c57361917d42e59aa3d5f5c72092444e972ebad2ba.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Schlieffen", }, Group:"", SpecialGroup:""},
+  3768497488: Warship{Name:"Almirante Abreu",PriceGold:1550,Nation:"pan_america",IsPremium:true,ShipID:3768497488,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PVSC502_3bc940aff63b8b825752ef3c69490857b5a207ac2407172b1d5b581e85269653.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Almirante Abreu", }, Group:"", SpecialGroup:""},
+  3764303312: Warship{Name:"Molotov",PriceGold:4550,Nation:"ussr",IsPremium:true,ShipID:3764303312,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC506_35f98188dc9d6615d7064ae96bb913ae7c4416d24bc5b9daf3369b22b0187622.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Molotov", }, Group:"", SpecialGroup:""},
+  3765384944: Warship{Name:"Giulio Cesare",PriceGold:5200,Nation:"italy",IsPremium:true,ShipID:3765384944,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB505_e886c50dba9c6453eab1d69b9bafb409082034ee3a12bf2e519538436679bae1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Giulio Cesare", }, Group:"", SpecialGroup:""},
+  3764270928: Warship{Name:"Aigle",PriceGold:4650,Nation:"france",IsPremium:true,ShipID:3764270928,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD506_fb43fa1f9fe16d7f4db30c203624b7057b3198ccfbde3fe9f30b4cb25b05e382.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Aigle", }, Group:"", SpecialGroup:""},
+  3764303664: Warship{Name:"Admiral Graf Spee",PriceGold:4700,Nation:"germany",IsPremium:true,ShipID:3764303664,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC506_cd19a0c7ddbdcc99aef6f5cc2b0a27bbed9881faad07bed279305f04e6fa5bdd.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Admiral Graf Spee", }, Group:"", SpecialGroup:""},
+  3764336624: Warship{Name:"Arizona",PriceGold:6600,Nation:"usa",IsPremium:true,ShipID:3764336624,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB506_453cedd653e813e75d7e76d15b45bf79c5fcd5aedf8bbcbba1fbdffcd8b27580.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Arizona", }, Group:"", SpecialGroup:""},
+  4074682352: Warship{Name:"Worcester",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4074682352,PriceCredit:19900000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC210_9458d9cdb708b6e713ccd2e0ddc0b51381ddf3957c9c265f22e7af352ecac785.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Worcester", }, Group:"", SpecialGroup:""},
+  3766368080: Warship{Name:"Siroco",PriceGold:3500,Nation:"france",IsPremium:true,ShipID:3766368080,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD504_e956632011251ef05455b7466982be235bacb51316cbf30c7fd8b97b18d27469.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Siroco", }, Group:"", SpecialGroup:""},
+  3969857328: Warship{Name:"Preussen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:3969857328,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB310_1befdda70a85cf84de0bcc4e9e3fc45aab91188004789b604d2302607b2f5446.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Preussen", }, Group:"", SpecialGroup:""},
+  3971888592: Warship{Name:"Kiev",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3971888592,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "3865982416": 160000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD308_325186da73a2bcb00040aa3c922695372b4c6d416847ca561d5a4298ef422aa5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kiev", }, Group:"", SpecialGroup:""},
+  3765351888: Warship{Name:"Krasny Krym",PriceGold:2950,Nation:"ussr",IsPremium:true,ShipID:3765351888,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC505_fd72a15acaf34823a84fcf131ff6be508c7b0ee5390109fdd3e710dd6af8b455.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Krasny Krym", }, Group:"", SpecialGroup:""},
+  3765385200: Warship{Name:"Oklahoma",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:3765385200,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB505_2c7cc243bc589cfb107750452d5a6173d22007698d1c8f7ccae55986f418da2d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Oklahoma", }, Group:"", SpecialGroup:""},
+  4178523856: Warship{Name:"Satsuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4178523856,PriceCredit:0,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB111_82c56a1b2eef233fa705e67dbf094eebbba151aaf59cc5352a544d36c36061fc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Satsuma", }, Group:"", SpecialGroup:""},
+  4076812080: Warship{Name:"Zieten",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4076812080,PriceCredit:1,Tier:8,NextShips:map[string]int64{ "4075763504": 0, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB208_368fda5e718d2d5ae4de071309172365d6834676e8cad22b8818de46174b864b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Zieten", }, Group:"", SpecialGroup:""},
+  3764303696: Warship{Name:"De Grasse",PriceGold:5500,Nation:"france",IsPremium:true,ShipID:3764303696,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC506_5a3b39c8b79ab063acd8125d7117e7e1a53e992e0640ccbf856d94cf336634b7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "De Grasse", }, Group:"", SpecialGroup:""},
+  3769513264: Warship{Name:"Błyskawica",PriceGold:4800,Nation:"europe",IsPremium:true,ShipID:3769513264,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD501_85e89984588cbe60601a42f1fb9d85b33994ab524e0884b5199ad0c58584a522.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Błyskawica", }, Group:"", SpecialGroup:""},
+  3764369232: Warship{Name:"Béarn",PriceGold:6000,Nation:"france",IsPremium:true,ShipID:3764369232,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSA506_1390b2cca525bd45b98026d0064db6d04c9367dbfb582f338cd09a703442605c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Béarn", }, Group:"", SpecialGroup:""},
+  4075730928: Warship{Name:"Seattle",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4075730928,PriceCredit:14100000,Tier:9,NextShips:map[string]int64{ "4074682352": 242000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC209_e66952791db4785c96f66f8af4ae476f47fb115976c5a7ccaeba97a8deda3a26.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Seattle", }, Group:"", SpecialGroup:""},
+  4075763504: Warship{Name:"Prinz Rupprecht",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4075763504,PriceCredit:0,Tier:9,NextShips:map[string]int64{ "4074714928": 1, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB209_7e152a1be98b44dae4b98ad011e346eefcee5e5e5735bc0471973df3ec6324d2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Prinz Rupprecht", }, Group:"", SpecialGroup:""},
+  4179506640: Warship{Name:"Khabarovsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179506640,PriceCredit:18800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD110_9ac511b0de97fc0d54efc78b1d37191bfd91fdade6e259f86280689d39d53830.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Khabarovsk", }, Group:"", SpecialGroup:""},
+  4077860656: Warship{Name:"Prinz Heinrich",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4077860656,PriceCredit:0,Tier:7,NextShips:map[string]int64{ "4076812080": 1, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB207_0861e24fce672517233a3629f15d8027f547d6b3463cbdbb035c901dee38aea0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Prinz Heinrich", }, Group:"", SpecialGroup:""},
+  3764336464: Warship{Name:"Dunkerque",PriceGold:5200,Nation:"france",IsPremium:true,ShipID:3764336464,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB506_6906e740759c67685cbb799de19eaea97793a41c1e632cb381237ee3c4592fa2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dunkerque", }, Group:"", SpecialGroup:""},
+  3767416176: Warship{Name:"Vampire",PriceGold:2300,Nation:"commonwealth",IsPremium:true,ShipID:3767416176,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PUSD503_b5e6657efeca92e6d36bf56e7bb8288f417aefc4f5a99ecb102bf3781df9d776.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vampire", }, Group:"", SpecialGroup:""},
+  3767449040: Warship{Name:"Oleg",PriceGold:2000,Nation:"ussr",IsPremium:true,ShipID:3767449040,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC503_223372889dbbd512f67666f118ce0b48a5925585f97e6aafaff7eb2801fca9ec.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Oleg", }, Group:"", SpecialGroup:""},
+  4078909232: Warship{Name:"Mackensen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4078909232,PriceCredit:1,Tier:6,NextShips:map[string]int64{ "4077860656": 0, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB206_ae0cf9776fd7b2ee5839a61650b6f01c463f1eb1a2d7dbf246e2b31b863acfe7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mackensen", }, Group:"", SpecialGroup:""},
+  3969824208: Warship{Name:"Petropavlovsk",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:3969824208,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC310_16b6c234614df8768902ac74552332da59ec93604b875d7793eb84683e0ec7f6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Petropavlovsk", }, Group:"", SpecialGroup:""},
+  4077828080: Warship{Name:"Helena",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4077828080,PriceCredit:5800000,Tier:7,NextShips:map[string]int64{ "4076779504": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC207_e4d8422459b071f8e81053c0c7e0083ffa550efc2c0358a1547c06643fb9bf17.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Helena", }, Group:"", SpecialGroup:""},
+  4074649424: Warship{Name:"Marceau",PriceGold:34650,Nation:"france",IsPremium:false,ShipID:4074649424,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD210_04c13e7c99b8afeb3d52bfac6d523beda85d08c2ada88b0a1b631ac93a0cc48d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Marceau", }, Group:"", SpecialGroup:""},
+  4074682320: Warship{Name:"Goliath",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4074682320,PriceCredit:20500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC210_3ed2b8e28c6b5fef34c9709aff78a38a5d1af08ed13bd89d5a3528113167d6c6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Goliath", }, Group:"", SpecialGroup:""},
+  4079957808: Warship{Name:"Derfflinger",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4079957808,PriceCredit:0,Tier:5,NextShips:map[string]int64{ "4078909232": 1, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB205_ea06966fe2535e281516bfeae2755745600d5ad712f923cddd293ca71ad2a82d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Derfflinger", }, Group:"", SpecialGroup:""},
+  3764270288: Warship{Name:"Anshan",PriceGold:3700,Nation:"pan_asia",IsPremium:true,ShipID:3764270288,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD506_c15e19bdc93bcccd33d8329062a460b4f886f657d9db057cae6717140a281155.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Anshan", }, Group:"", SpecialGroup:""},
+  4076746192: Warship{Name:"Ognevoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4076746192,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4182652368": 160000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD208_41357039a466e92f7cda55a6d53866c3913d9575733573df2c0dc603d5e90873.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ognevoi", }, Group:"", SpecialGroup:""},
+  4076779504: Warship{Name:"Cleveland",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4076779504,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4075730928": 169000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC208_0e978db1d04d5995a49bdb2441aa19df9563cef3b24cf4dd40401d591eefa7ca.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cleveland", }, Group:"", SpecialGroup:""},
+  4075730896: Warship{Name:"Drake",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4075730896,PriceCredit:13800000,Tier:9,NextShips:map[string]int64{ "4074682320": 244000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC209_d0f7e7c3635a08ed11aa8f5aa0d22dde1948a98171447be5364b51b17f80833a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Drake", }, Group:"", SpecialGroup:""},
+  4179506384: Warship{Name:"Yueyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4179506384,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD110_c87b452aed8b57078d48466e7919467e3f6aaaefbd8f0da7b24b4a085669e7c9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yueyang", }, Group:"", SpecialGroup:""},
+  4081006384: Warship{Name:"Moltke",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4081006384,PriceCredit:1,Tier:4,NextShips:map[string]int64{ "4079957808": 0, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB204_f9d00ab57298efe4404f9a4e85f65bf2ddb977d4cbc1b86ac0867798e78978f7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Moltke", }, Group:"", SpecialGroup:""},
+  4074649040: Warship{Name:"Grozovoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4074649040,PriceCredit:18000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD210_feb6e779076ba64a93a2f295111228b4689bbc47d48c11eac2b31ef13e86b775.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Grozovoi", }, Group:"", SpecialGroup:""},
+  4178458064: Warship{Name:"Zorkiy",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4178458064,PriceCredit:1,Tier:11,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD111_dfb526e1f11e4d711ac7bdf1bdf1bb7039d616dbba4a76c1652e4733fd6107cc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Zorkiy", }, Group:"", SpecialGroup:""},
+  3764270832: Warship{Name:"Leone",PriceGold:5000,Nation:"italy",IsPremium:true,ShipID:3764270832,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISD506_bdbc7333f7c1744f5415f52cb7f6bc6b137831544f6c2338511c9ab44029dd1d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Leone", }, Group:"", SpecialGroup:""},
+  3764270416: Warship{Name:"Juruá",PriceGold:5000,Nation:"pan_america",IsPremium:true,ShipID:3764270416,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PVSD506_1b86f4d7975064be109d50ac3356866a76c2ca1ed202b181d97ac057f0c2fa26.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Juruá", }, Group:"", SpecialGroup:""},
+  3767449584: Warship{Name:"Charleston",PriceGold:2000,Nation:"usa",IsPremium:true,ShipID:3767449584,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC503_29e3674b91a42e90e45659c44135b6a7fbc14ad54aa61bbebaa64a1230592bde.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Charleston", }, Group:"", SpecialGroup:""},
+  4076779472: Warship{Name:"Albemarle",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4076779472,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4075730896": 176000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC208_9814c4d69c8b9ed642fd5b8664b1e36642c32c8c2fef957df4ef082ba0852d15.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Albemarle", }, Group:"", SpecialGroup:""},
+  4075697968: Warship{Name:"Felix Schultz",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4075697968,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4074649392": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD209_4e70db0f2e72450d7071b9ac5cc184c95a69c7d53a24d3e46831f684e34ea122.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Felix Schultz", }, Group:"", SpecialGroup:""},
+  4082054960: Warship{Name:"Von der Tann",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4082054960,PriceCredit:0,Tier:3,NextShips:map[string]int64{ "4081006384": 1, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB203_524e1bac7b6242ca924e5bb336820685680b64bd89fff6ab55f778a6a73db5d7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Von der Tann", }, Group:"", SpecialGroup:""},
+  3765319664: Warship{Name:"Hill",PriceGold:3500,Nation:"usa",IsPremium:true,ShipID:3765319664,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD505_6e5d7f1db6cc0b3fedfb48455a8babe6454907a79f8d6b6767d19cde739273a6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hill", }, Group:"", SpecialGroup:""},
+  3765319120: Warship{Name:"Okhotnik",PriceGold:2700,Nation:"ussr",IsPremium:true,ShipID:3765319120,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD505_fb23e6dda9643f61e83082be2c990505e97f224fc31abe23a894bbd596900970.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Okhotnik", }, Group:"", SpecialGroup:""},
+  4078843344: Warship{Name:"Gnevny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4078843344,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4077794768": 68000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD206_888f2ff51238f5c02ad3ccb5825078ac3f116cc3fa9a5b1f10de8a864db82c3b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gnevny", }, Group:"", SpecialGroup:""},
+  4183733456: Warship{Name:"Rahmat",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4183733456,PriceCredit:1,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC106_044027ed9ac355e726446eed4a604123ff8092c29ea6220276ec5f801bdd0267.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Rahmat", }, Group:"", SpecialGroup:""},
+  4181636304: Warship{Name:"Harbin",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4181636304,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC108_a36ae96970d8955915ffaf3b43613c7bca1d6b77cb24ba8369fa894c43e525eb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Harbin", }, Group:"", SpecialGroup:""},
+  4183733712: Warship{Name:"Budyonny",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183733712,PriceCredit:2850000,Tier:6,NextShips:map[string]int64{ "4182685136": 69500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC106_198f6dbcd2f79b43a562584257ecc83315c55cd644a89fbb35d1e4e77c3f3c39.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Budyonny", }, Group:"", SpecialGroup:""},
+  4179539408: Warship{Name:"Moskva",PriceGold:34650,Nation:"ussr",IsPremium:false,ShipID:4179539408,PriceCredit:0,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC110_af66b6adce0ba16a9021dfab5f6e28835e4d53da800972171655d9d034d90fbf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Moskva", }, Group:"", SpecialGroup:""},
+  4181702096: Warship{Name:"Pobeda",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181702096,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179604944": 355000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSA108_312686ad6a000443d7647ac528772b71f7ca23bc29cfacc6ac122e5744917e4e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pobeda", }, Group:"", SpecialGroup:""},
+  4182652208: Warship{Name:"Skåne",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4182652208,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "4181603632": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD107_b0b86ce815e1f39bd40c3b32296c1f5ee19c594d4dd75b6dff6b7ee4e1126048.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Skåne", }, Group:"", SpecialGroup:""},
+  4182652624: Warship{Name:"Akatsuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4182652624,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4076746448": 115000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD107_405b2e05bc1ac626e6ea7aedd92140e9d1aab95c025a3b3d289aade037e41494.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Akatsuki", }, Group:"", SpecialGroup:""},
+  4181604048: Warship{Name:"Akizuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4181604048,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4065212112": 154000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD108_fe0c2317c51024e09486238f9dd9363fd5830d82672ecb123cadec4b71aa21cb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Akizuki", }, Group:"", SpecialGroup:""},
+  4180554960: Warship{Name:"Chung Mu",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4180554960,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179506384": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD109_01c2cd5db13b8d1dc93d3b9d289e5a47be6d36e3f39da11253e5f8c2ab335ae3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chung Mu", }, Group:"", SpecialGroup:""},
+  4180588528: Warship{Name:"Buffalo",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4180588528,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4273911792": 237500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC109_f4bdcdf5a7540b770044cc335d746feb6e36ec97fef9515ccb440ab6cdf161cb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Buffalo", }, Group:"", SpecialGroup:""},
+  4183701456: Warship{Name:"Icarus",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183701456,PriceCredit:3200000,Tier:6,NextShips:map[string]int64{ "4182652880": 65000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD106_4a0811ab7b296ed9aac3c16670654f7acf5e00371d5e02bfc798b3a6b504e6c6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Icarus", }, Group:"", SpecialGroup:""},
+  4179539920: Warship{Name:"Minotaur",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179539920,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC110_616426f25b262f14ad40b63d1cf9264b236c37cb3a2b574dc197e5b81f10881d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Minotaur", }, Group:"", SpecialGroup:""},
+  4179572176: Warship{Name:"Kremlin",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179572176,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB110_a1be11ad68f17faf4282c7e80221255b52c34d1454e70cb53a32f1b79b23fc3f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kremlin", }, Group:"", SpecialGroup:""},
+  4182718192: Warship{Name:"Francesco Caracciolo",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4182718192,PriceCredit:6500000,Tier:7,NextShips:map[string]int64{ "4181669616": 140000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB107_f9535943d377bea1509c39096e93cbbc6f265010c13eb8ca03a57830665134a6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Francesco Caracciolo", }, Group:"", SpecialGroup:""},
+  4181637104: Warship{Name:"Baltimore",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181637104,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180588528": 165000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC108_21ac1f822b53652ae435f58f86426f05265e4184ef9eba180ec95fd4c607586b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Baltimore", }, Group:"", SpecialGroup:""},
+  4180555728: Warship{Name:"Jutland",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180555728,PriceCredit:13250000,Tier:9,NextShips:map[string]int64{ "4179507152": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD109_18c0d244d9eb78572a78913c5db0b86c0f2dcace2ef88aab2ac5690e83f9cff4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jutland", }, Group:"", SpecialGroup:""},
+  4180555600: Warship{Name:"Mogador",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180555600,PriceCredit:13250000,Tier:9,NextShips:map[string]int64{ "4179507024": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD109_cb62cfa1d4bfb5130743945793734c2379ebb243af1ae0eee1bebb12042ca69e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mogador", }, Group:"", SpecialGroup:""},
+  4180588496: Warship{Name:"Neptune",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180588496,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4179539920": 238000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC109_68f4d8117a230d7e27ad8c6d5d8442fb225a426b3dc2d2883e1d6ca5522f2612.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Neptune", }, Group:"", SpecialGroup:""},
+  4180588272: Warship{Name:"Brindisi",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4180588272,PriceCredit:14000000,Tier:9,NextShips:map[string]int64{ "4179539696": 238000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC109_3206b68f365f466d66a7e1718de1ccb0b4e7f6064dabf7f252ab6d4e566dce6b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Brindisi", }, Group:"", SpecialGroup:""},
+  4179604944: Warship{Name:"Admiral Nakhimov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4179604944,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSA110_cc2a114f876010539e4aa6f8c21c805217b41d59de355a4a396721bf855d75e9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Admiral Nakhimov", }, Group:"", SpecialGroup:""},
+  4182685456: Warship{Name:"Eendracht",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4182685456,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4181636880": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC107_c8ea4f3e501622147a955d8017d990debe2f6a61e38504e41f421cafce05f932.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Eendracht", }, Group:"", SpecialGroup:""},
+  4182685648: Warship{Name:"Fiji",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182685648,PriceCredit:5500000,Tier:7,NextShips:map[string]int64{ "4181637072": 108000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC107_5f1c71b0f387d72fd4316b0d9a16de5032fcfe1dc095abe9a756fbd1bef2e554.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fiji", }, Group:"", SpecialGroup:""},
+  4181604176: Warship{Name:"Le Fantasque",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181604176,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180555600": 166000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD108_8a7e2c5f5ffb0999e0713b094c0a81a740f330744493e8b86897823b2275b335.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Le Fantasque", }, Group:"", SpecialGroup:""},
+  4181637072: Warship{Name:"Edinburgh",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181637072,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180588496": 173000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC108_dccdb8ebad1fda321c8abc30c45c719c96826e10c0518daefc722117ca13c11f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Edinburgh", }, Group:"", SpecialGroup:""},
+  4183701296: Warship{Name:"Ernst Gaede",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183701296,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4077795120": 69000,"4182652720": 64000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD106_e6af2fb755c921e56af3dbe8759ff96ff9a06af0c74ffdc73c3ce163b51c67c1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ernst Gaede", }, Group:"", SpecialGroup:""},
+  4180555568: Warship{Name:"Z-46",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180555568,PriceCredit:12200000,Tier:9,NextShips:map[string]int64{ "4179506992": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD109_0f0f3c787bb5f08f1b7b27900ddfb76bd3a05554746212d87d166ecfa95e84c9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-46", }, Group:"", SpecialGroup:""},
+  4181702448: Warship{Name:"August von Parseval",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181702448,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605296": 355000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA108_761a6aa5312aea31334fc9d251c779f74ed9ccec578941b5168cce43a7b8f72f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "August von Parseval", }, Group:"", SpecialGroup:""},
+  4179605200: Warship{Name:"Hakuryū",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4179605200,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA110_00695fda2927f4e847c390fef1fc00a72ecb9eeebb7cfa439fdb696dea539764.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hakuryū", }, Group:"", SpecialGroup:""},
+  4183734256: Warship{Name:"Pensacola",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4183734256,PriceCredit:2800000,Tier:6,NextShips:map[string]int64{ "4182685680": 70000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC106_01607f57ce654de73a1c2c496f1d093458f40f2506e142040e17cc68fe7b63e5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pensacola", }, Group:"", SpecialGroup:""},
+  4181669840: Warship{Name:"Monarch",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181669840,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621264": 180000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB108_d88de642b61be0c6db52d12280e33e2e9a50731cb5cb2004cb6f53f7a66de21e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Monarch", }, Group:"", SpecialGroup:""},
+  4182685488: Warship{Name:"Yorck",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182685488,PriceCredit:5650000,Tier:7,NextShips:map[string]int64{ "4181636912": 114000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC107_66822a0b94d76dc69a836a4dde6d4851f873d6a5003fc1dc002fa3e85ba0afc1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yorck", }, Group:"", SpecialGroup:""},
+  4181702608: Warship{Name:"Implacable",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181702608,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4074747856": 355000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA108_4a287b382934dace4eada22f78f2530be5403bf8e49ca245e1ff3d4e7fcfef2b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Implacable", }, Group:"", SpecialGroup:""},
+  4183734224: Warship{Name:"Leander",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183734224,PriceCredit:3040000,Tier:6,NextShips:map[string]int64{ "4182685648": 70000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC106_1d88d328d751b79f7d550ca407003f0592cafc95c0fe92116b6cc34c5d393a47.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Leander", }, Group:"", SpecialGroup:""},
+  4180621104: Warship{Name:"Friedrich der Grosse",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180621104,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572528": 255000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB109_a4c6684ba41bbb922a684ed1055c2163849f4e3cad992e2c7842cb07bd861556.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Friedrich der Grosse", }, Group:"", SpecialGroup:""},
+  4182652720: Warship{Name:"Leberecht Maass",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182652720,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181604144": 109000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD107_b238d268b8b682dcda19fac084ef4f1dbc31eaeb826b8045d728f89d577b4bff.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Leberecht Maass", }, Group:"", SpecialGroup:""},
+  4180587984: Warship{Name:"Dmitri Donskoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4180587984,PriceCredit:13750000,Tier:9,NextShips:map[string]int64{ "4074681808": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC109_6e62356b246ac7ba42f7b61c465a4da6ddb1be2e51b61f1f64b589d8e4c8300d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dmitri Donskoi", }, Group:"", SpecialGroup:""},
+  4179539792: Warship{Name:"Henri IV",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179539792,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC110_80d6751b4a7232cb1c3c30c33baeffc7e8195a244d7e249298ee737cc12ee3e1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Henri IV", }, Group:"", SpecialGroup:""},
+  4179605296: Warship{Name:"Manfred von Richthofen",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179605296,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA110_edfc71a832b8e0f6ec275a6c3efef9dd6cec9a8a5614cd1a4686213a70f574a4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Manfred von Richthofen", }, Group:"", SpecialGroup:""},
+  4183700688: Warship{Name:"Fushun",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4183700688,PriceCredit:2990000,Tier:6,NextShips:map[string]int64{ "4182652112": 64000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD106_9c86ed26df83e8a946fde426545d0869d3a9f49df9cd6e60360b3c8ede3ecb7c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fushun", }, Group:"", SpecialGroup:""},
+  4181636560: Warship{Name:"Chapayev",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181636560,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180587984": 167000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC108_a15e11e6fb1d7d7728462af26fba0540d586d3f32a3e8c328899caae1391cea9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chapayev", }, Group:"", SpecialGroup:""},
+  4180555056: Warship{Name:"Östergötland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4180555056,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179506480": 235000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD109_fe82b09bb8309cec143b454c04f806ca0a36340ed3d93343171cda16f070042b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Östergötland", }, Group:"", SpecialGroup:""},
+  4182684880: Warship{Name:"Chumphon",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4182684880,PriceCredit:1,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC107_a54ea10f02e6cd827b7f4a3b0256fcb168410bb89b5325433472a4b948c67a1a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chumphon", }, Group:"", SpecialGroup:""},
+  4181702352: Warship{Name:"Shōkaku",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4181702352,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605200": 355000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA108_fa0b8145aaed9d39cbe0ffab87bd6b044d7bf1e8397d60584a5ad961409dc49d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shōkaku", }, Group:"", SpecialGroup:""},
+  4180588368: Warship{Name:"Saint-Louis",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180588368,PriceCredit:13600000,Tier:9,NextShips:map[string]int64{ "4179539792": 238000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC109_9e5adab0696dcd00aad2a001c0044031c2f2fde15102bd97957c9de934681ed2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Saint-Louis", }, Group:"", SpecialGroup:""},
+  4180621264: Warship{Name:"Lion",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4180621264,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572688": 255000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB109_4de85c10372b560b14163404da0723697e19950c6525902413913e5cd978a106.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lion", }, Group:"", SpecialGroup:""},
+  4179539760: Warship{Name:"Hindenburg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179539760,PriceCredit:19600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC110_4635332915d94f64dbdc1fda94f7f2f0bfd227355eab5406b16f5d2e81006c3d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hindenburg", }, Group:"", SpecialGroup:""},
+  4179572464: Warship{Name:"Cristoforo Colombo",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4179572464,PriceCredit:20700000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB110_7a8d3c1390bb080718b20400ab4f3df8fec29e250f0e67a5d160f1fc0cf94384.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Cristoforo Colombo", }, Group:"", SpecialGroup:""},
+  4181636848: Warship{Name:"Amalfi",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4181636848,PriceCredit:9100000,Tier:8,NextShips:map[string]int64{ "4180588272": 160000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC108_b8903b976c2d5c890c72ccb64b20765ba7e3f8be1fb9ea3604e62973fb77b00a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Amalfi", }, Group:"", SpecialGroup:""},
+  4182685136: Warship{Name:"Shchors",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182685136,PriceCredit:5600000,Tier:7,NextShips:map[string]int64{ "4076778960": 110000,"4181636560": 112000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC107_7baaecb5180978c8e4ba297edebfd65b009019e691674c95a337a8c8037ae31d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shchors", }, Group:"", SpecialGroup:""},
+  4179539696: Warship{Name:"Venezia",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4179539696,PriceCredit:19900000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC110_2d4334aeecca7ef0dcaf862a1e3717e890d0511e86941fb8ebee2220ba4f412d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Venezia", }, Group:"", SpecialGroup:""},
+  4181636944: Warship{Name:"Charles Martel",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181636944,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180588368": 165000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC108_adebf90c6764bb009435f003175cf287355caa7716322d88a7c1c3bbbe87d116.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Charles Martel", }, Group:"", SpecialGroup:""},
+  4180588336: Warship{Name:"Roon",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4180588336,PriceCredit:13700000,Tier:9,NextShips:map[string]int64{ "4179539760": 238000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC109_7c46eca44e99cb1c0b0c9c8f5fddaa71fc41d5c14d18c65ba5ef55a5c0a92a6b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Roon", }, Group:"", SpecialGroup:""},
+  4183766864: Warship{Name:"Normandie",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183766864,PriceCredit:3470000,Tier:6,NextShips:map[string]int64{ "4182718288": 72500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB106_3ba372f12637b3ca6c4243787f128cdc619a2e9281e187b05632269b712d9c4b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Normandie", }, Group:"", SpecialGroup:""},
+  4179539728: Warship{Name:"Gouden Leeuw",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4179539728,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC110_dd5fcb0a05c718a05f36f348cd038ca69747e6a099376d2d424a80dd6cd6998f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gouden Leeuw", }, Group:"", SpecialGroup:""},
+  4181669616: Warship{Name:"Vittorio Veneto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4181669616,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621040": 180000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB108_0f808ef8d9d06b13bc524dfecb00f51b38c3be9d5b4ae95f6a26afbe61126300.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vittorio Veneto", }, Group:"", SpecialGroup:""},
+  4182685520: Warship{Name:"Algérie",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182685520,PriceCredit:5600000,Tier:7,NextShips:map[string]int64{ "4181636944": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC107_4418cdb14fe0786127b50ad58bc6bd8b3582556461a0fd83dd36c1bcac4038a7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Algérie", }, Group:"", SpecialGroup:""},
+  4181604144: Warship{Name:"Z-23",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181604144,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180555568": 150000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD108_f7e5ea5d19de987be211bd72d27cafa3a5dacc748d5980cc724cb76e6839888d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Z-23", }, Group:"", SpecialGroup:""},
+  4182685680: Warship{Name:"New Orleans",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4182685680,PriceCredit:5570000,Tier:7,NextShips:map[string]int64{ "4181637104": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC107_0313da8b996f2dcc654d6f4aa69d8a19c5ffc29b42a012bcb97768249f77697b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "New Orleans", }, Group:"", SpecialGroup:""},
+  4182718416: Warship{Name:"King George V",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182718416,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669840": 135000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB107_35e213cc34334f29da7d62c679924450ee6e0020f0052ad26edd3ba30a4df2ed.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "King George V", }, Group:"", SpecialGroup:""},
+  4183701328: Warship{Name:"Guépard",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183701328,PriceCredit:3200000,Tier:6,NextShips:map[string]int64{ "4182652752": 64000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD106_07856c0cab04877d101075208a9af9bb23aa91d475cf7ef03358e26b19b508ef.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Guépard", }, Group:"", SpecialGroup:""},
+  4181636912: Warship{Name:"Admiral Hipper",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181636912,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180588336": 168000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC108_dc6b59b5f648bc0dabb4854f50c9e11d0d4f429543c890fb49467f66906e6524.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Admiral Hipper", }, Group:"", SpecialGroup:""},
+  4183700784: Warship{Name:"Västerås",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4183700784,PriceCredit:3000000,Tier:6,NextShips:map[string]int64{ "4182652208": 65000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD106_39d29cc104cd52834f453c1255094a7e01155754897fadfe90f9e21eeeaae1bb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Västerås", }, Group:"", SpecialGroup:""},
+  4183701200: Warship{Name:"Fubuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4183701200,PriceCredit:2950000,Tier:6,NextShips:map[string]int64{ "4182652624": 63000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD106_6a768ab5c3dc4fe4976cc5d5aad150fa6b6399a235404aee4c0db718ae8723d1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fubuki", }, Group:"", SpecialGroup:""},
+  4179572720: Warship{Name:"Vermont",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4179572720,PriceCredit:20700000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB110_72d6a5d57676a979336aae31420bb74a9582232d0fd2bc1586187b8afccd75ac.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vermont", }, Group:"", SpecialGroup:""},
+  4183734096: Warship{Name:"La Galissonnière",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4183734096,PriceCredit:2850000,Tier:6,NextShips:map[string]int64{ "4182685520": 70000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC106_e5565e1a912cf19bd5bea73c75a60a6a5d58962cba56462e2a8ec849199b1004.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "La Galissonnière", }, Group:"", SpecialGroup:""},
+  4181603632: Warship{Name:"Öland",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4181603632,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180555056": 160000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD108_f5f6f894a4ef355e4618e914e5611cfb9809fb5191a7498f4bd88d925c887ffe.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Öland", }, Group:"", SpecialGroup:""},
+  4182652752: Warship{Name:"Vauquelin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182652752,PriceCredit:5150000,Tier:7,NextShips:map[string]int64{ "4181604176": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD107_643a4aef7798ecbb8feb34689989c6af58f455e5dcdfee4565195c90e2c1183f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vauquelin", }, Group:"", SpecialGroup:""},
+  4181636880: Warship{Name:"Haarlem",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4181636880,PriceCredit:9000000,Tier:8,NextShips:map[string]int64{ "4180588304": 170000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC108_44723bbfbd5533f7cc6510ea6146550f6a696b13d11c47bdca1803a663f95c63.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Haarlem", }, Group:"", SpecialGroup:""},
+  4180620752: Warship{Name:"Sovetsky Soyuz",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4180620752,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4179572176": 255000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB109_1f7368aa523289c7da41454eda799ec135a6a1b7663aafb6f62a62b1b0013053.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sovetsky Soyuz", }, Group:"", SpecialGroup:""},
+  4179572528: Warship{Name:"Grosser Kurfürst",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4179572528,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB110_9b3dbcaf7d4508435c97bc3b30de24490dc70b24cf6e49794f0681db9ec86bfe.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Grosser Kurfürst", }, Group:"", SpecialGroup:""},
+  4179605488: Warship{Name:"Midway",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4179605488,PriceCredit:28000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA110_4d771eaae5b865a45b94b367db15a494aa27262a7d90c215fdc6bf447e6ee219.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Midway", }, Group:"", SpecialGroup:""},
+  4183734064: Warship{Name:"Nürnberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183734064,PriceCredit:3040000,Tier:6,NextShips:map[string]int64{ "4182685488": 70500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC106_d8710dbe24d48e935adff6eb41a193491251d1af7a1248d7e4c4fcb7aecc6090.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nürnberg", }, Group:"", SpecialGroup:""},
+  4181669712: Warship{Name:"Richelieu",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4181669712,PriceCredit:10500000,Tier:8,NextShips:map[string]int64{ "4180621136": 190000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB108_78bc8e2ed7c1807528505626a885df1353db145214de80d177c4d9138702d7a8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Richelieu", }, Group:"", SpecialGroup:""},
+  4181669328: Warship{Name:"Vladivostok",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4181669328,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180620752": 180000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB108_1cbde9121cc80de369459bef0bd5e1b4c8670633c83557b90b8df5e19b582d1e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Vladivostok", }, Group:"", SpecialGroup:""},
+  4180621136: Warship{Name:"Alsace",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4180621136,PriceCredit:16900000,Tier:9,NextShips:map[string]int64{ "4179572560": 260000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB109_7b9f824315b047ce1ffd80b118a241de0f013c73a5dc0584433ef1548cfc4c8f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alsace", }, Group:"", SpecialGroup:""},
+  4179507152: Warship{Name:"Daring",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179507152,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD110_8e9d39862a74b0f40739cefe2e8aa1929396dfef44d5863b5332eae30ace21d7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Daring", }, Group:"", SpecialGroup:""},
+  4182652112: Warship{Name:"Gadjah Mada",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4182652112,PriceCredit:5100000,Tier:7,NextShips:map[string]int64{ "4181603536": 110000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD107_6a303812ba4d1ed539eaa5a5442f16196c371c3b528fcc888df00ddaa48f563d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gadjah Mada", }, Group:"", SpecialGroup:""},
+  4182685424: Warship{Name:"Zara",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4182685424,PriceCredit:5700000,Tier:7,NextShips:map[string]int64{ "4181636848": 105000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC107_7c363796b0c302099f695047effa66f39200f14447c80f473280596265cac47d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Zara", }, Group:"", SpecialGroup:""},
+  4182717904: Warship{Name:"Sinop",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182717904,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669328": 135000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB107_cf9d57e0074c918d64e895b3f4e8d00f2749e3aca714c1b0edd71cf8ab320152.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sinop", }, Group:"", SpecialGroup:""},
+  4180588304: Warship{Name:"Johan de Witt",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4180588304,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4179539728": 240000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC109_97db70b13bb2dd22d7fc73c30b6fb1d7e3dd8ee66697ee22955217119a758842.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Johan de Witt", }, Group:"", SpecialGroup:""},
+  4183766768: Warship{Name:"Andrea Doria",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4183766768,PriceCredit:3500000,Tier:6,NextShips:map[string]int64{ "4182718192": 80000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB106_5896eaca6a4ae46a54dd24971021f670a148c3713df48f357353cb38099dd052.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Andrea Doria", }, Group:"", SpecialGroup:""},
+  4179539152: Warship{Name:"Jinan",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4179539152,PriceCredit:1,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC110_24953e27d3413dea76623a0ed50b849adc4565aee2391b09395e908f5962bfce.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jinan", }, Group:"", SpecialGroup:""},
+  4183766480: Warship{Name:"Izmail",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183766480,PriceCredit:3660000,Tier:6,NextShips:map[string]int64{ "4182717904": 80500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB106_f20b9c4175084ab8776ce50df944c12f0d10e5dd347f1d74b635e4dcdc2b6569.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Izmail", }, Group:"", SpecialGroup:""},
+  4183734032: Warship{Name:"Kijkduin",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4183734032,PriceCredit:3000000,Tier:6,NextShips:map[string]int64{ "4182685456": 72000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC106_ce34c8b1a131cddac2b3607e93a9c2eb94d2cff34c222a669bdd77d8307827c3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kijkduin", }, Group:"", SpecialGroup:""},
+  4182718288: Warship{Name:"Lyon",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4182718288,PriceCredit:6000000,Tier:7,NextShips:map[string]int64{ "4181669712": 136000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB107_f3cc0af62c017cd98163d214f01219018078bd1ad736ef5c582fb5e6f912c355.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lyon", }, Group:"", SpecialGroup:""},
+  4181604304: Warship{Name:"Lightning",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4181604304,PriceCredit:8900000,Tier:8,NextShips:map[string]int64{ "4180555728": 165000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD108_98db74991b2fcb36d1ed0606dcc85336433862aed3fa396bccb96edb3dfdbcfc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lightning", }, Group:"", SpecialGroup:""},
+  4181603536: Warship{Name:"Hsienyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4181603536,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4180554960": 160000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD108_3cf1a30e667e581e46b0764f3dcc3725fad3dd64af4a2f85d817c43235e425a4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hsienyang", }, Group:"", SpecialGroup:""},
+  4181669680: Warship{Name:"Bismarck",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4181669680,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621104": 180000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB108_9e1ed243aae21ca2487345acf3c5a5701b8cbd4f2e3fa2dad7f64f9865df1885.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bismarck", }, Group:"", SpecialGroup:""},
+  4179572688: Warship{Name:"Conqueror",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4179572688,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB110_135ede5e893e5f2cf148f7a8d1b81fdc2bbb130a2855cc2c76c1c24fe9eef604.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Conqueror", }, Group:"", SpecialGroup:""},
+  4183766832: Warship{Name:"Bayern",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183766832,PriceCredit:3470000,Tier:6,NextShips:map[string]int64{ "4182718256": 80500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB106_e44780fcd179d6c2ca875ae7d167182a32202a4a1e21c8e61dcef90fcec2e0ee.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bayern", }, Group:"", SpecialGroup:""},
+  4183734000: Warship{Name:"Trento",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4183734000,PriceCredit:2900000,Tier:6,NextShips:map[string]int64{ "4182685424": 68000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC106_4515893a42998a71a47e6ec92eb41a0226d59d8596556e8a1781221e0bc48139.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Trento", }, Group:"", SpecialGroup:""},
+  4179572560: Warship{Name:"République",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4179572560,PriceCredit:21000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB110_9c674073cd4e0c582f6dcd59a24f36352306730731035e035e5e6e63a68f68f2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "République", }, Group:"", SpecialGroup:""},
+  4180621296: Warship{Name:"Minnesota",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4180621296,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4179572720": 255000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB109_bfb844247d4e268c81a646323938b62aec645137c9472ffa34ab4d1137aa438c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Minnesota", }, Group:"", SpecialGroup:""},
+  4182652880: Warship{Name:"Jervis",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4182652880,PriceCredit:5150000,Tier:7,NextShips:map[string]int64{ "4181604304": 112000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD107_9b6d60dbdbb1db6faa1bc21b4971eab6e959fcd28f24e60bf07e07249964bae0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jervis", }, Group:"", SpecialGroup:""},
+  4180587728: Warship{Name:"Sejong",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4180587728,PriceCredit:0,Tier:9,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC109_2eb2af79f69eb85001cb1ac7f9bfb948a8359c14faed3640ebfb015a79097b56.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sejong", }, Group:"", SpecialGroup:""},
+  4182718256: Warship{Name:"Gneisenau",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4182718256,PriceCredit:6700000,Tier:7,NextShips:map[string]int64{ "4181669680": 135000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB107_d40dddfed38d402024b9c438bcd1999dccf85f2766e6c88ea29044e1f020a285.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gneisenau", }, Group:"", SpecialGroup:""},
+  4181702640: Warship{Name:"Lexington",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181702640,PriceCredit:11500000,Tier:8,NextShips:map[string]int64{ "4179605488": 355000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA108_386d630f0633afb7d8780c7cf00a0ba01965695be83953501f4da7aad4d60bb1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lexington", }, Group:"", SpecialGroup:""},
+  4182652368: Warship{Name:"Udaloi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4182652368,PriceCredit:13000000,Tier:9,NextShips:map[string]int64{ "4074649040": 225000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD107_b417331f1fe8559fa6c605a1642061c1d46e6aa093a65655f945d5d3a76d46af.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Udaloi", }, Group:"", SpecialGroup:""},
+  4180621040: Warship{Name:"Lepanto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4180621040,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4179572464": 255000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB109_fa301897b5d35c992536df727d8223f818ef5805be81b1b38a29f88d4a0b5388.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Lepanto", }, Group:"", SpecialGroup:""},
+  4181669872: Warship{Name:"Kansas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4181669872,PriceCredit:11000000,Tier:8,NextShips:map[string]int64{ "4180621296": 180000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB108_0e6488586c9c9eb523cd69cc49d33dc5c175347d3da94d23d31c3be1c2df6514.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kansas", }, Group:"", SpecialGroup:""},
+  4185896944: Warship{Name:"Langley",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4185896944,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799792": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA104_cb705686614cf036e6947347c24e6145f96c400bb11a747e964bc44845af739a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Langley", }, Group:"", SpecialGroup:""},
+  4184815440: Warship{Name:"Bretagne",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184815440,PriceCredit:1680000,Tier:5,NextShips:map[string]int64{ "4183766864": 42500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB105_1095066e7833a8e5741e694be74003a48247143019d2eb5f367abd7183fe8694.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bretagne", }, Group:"", SpecialGroup:""},
+  4187894992: Warship{Name:"Longjiang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4187894992,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4186846416": 2100, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD102_56803877da32e7f15061c4b29f51d31fa5afd90dd6f1a3ce341f17846fb235db.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Longjiang", }, Group:"", SpecialGroup:""},
+  4188976592: Warship{Name:"Orlan",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4188976592,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928016": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC101_5ce5d3990f5acc716cd15436522b59d05373dee3f0480355b31823eb77b5f534.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Orlan", }, Group:"", SpecialGroup:""},
+  4185830864: Warship{Name:"Svietlana",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185830864,PriceCredit:666000,Tier:4,NextShips:map[string]int64{ "4069438928": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC104_22b554c235d37c6304367311ccd9bb45c7a4a0a2cfafd21232474ede791c7f46.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Svietlana", }, Group:"", SpecialGroup:""},
+  4186846512: Warship{Name:"Romulus",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4186846512,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4185797936": 6000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD103_92a629d15d635d7431a113722346eb6eaa85b4e49e508ad989f9aa027d02399f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Romulus", }, Group:"", SpecialGroup:""},
+  4267587280: Warship{Name:"Kamikaze R",PriceGold:4000,Nation:"japan",IsPremium:true,ShipID:4267587280,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD026_ae4c4e5124b791147bd405a984b325e4dc0cd1272c64464df203ae644dcf84f4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kamikaze R", }, Group:"", SpecialGroup:""},
+  4277024464: Warship{Name:"Fūjin",PriceGold:5000,Nation:"japan",IsPremium:true,ShipID:4277024464,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD017_e674ae3673bb6ca14a72b76a2e5cc071ea1ec38e81fc44ee782813139b97061f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fūjin", }, Group:"", SpecialGroup:""},
+  4247697392: Warship{Name:"Marblehead Lima",PriceGold:3100,Nation:"usa",IsPremium:true,ShipID:4247697392,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC045_00270098fa52f03895dd251db098c25f052ba5aaf31968c61fc6234001fa6a25.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Marblehead Lima", }, Group:"", SpecialGroup:""},
+  4276041712: Warship{Name:"Iowa",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4276041712,PriceCredit:15500000,Tier:9,NextShips:map[string]int64{ "4277090288": 247000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB018_0c5d0ae4701f480da30eba22b7a30acaa433fc44fc5539b260764be8dedcd28c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Iowa", }, Group:"", SpecialGroup:""},
+  4187895760: Warship{Name:"Medea",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4187895760,PriceCredit:65000,Tier:2,NextShips:map[string]int64{ "4186847184": 2150, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD102_7c5b33c7e7a8d932e426731ef876bc5ae5012ba9d55419d0e01e460374097d7c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Medea", }, Group:"", SpecialGroup:""},
+  4185864016: Warship{Name:"Courbet",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185864016,PriceCredit:774000,Tier:4,NextShips:map[string]int64{ "4184815440": 12500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB104_9469955256835a0194f8ac14f821a40624aacd1be87199c03a8c30b24550eb18.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Courbet", }, Group:"", SpecialGroup:""},
+  4186912208: Warship{Name:"Knyaz Suvorov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186912208,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185863632": 6700, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB103_de0ab8e776f813bad9a3eb96aad60913df194d38a3807ca5648c2e6b2a4826f6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Knyaz Suvorov", }, Group:"", SpecialGroup:""},
+  4188976432: Warship{Name:"Gryf",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4188976432,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187895088": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSC101_1dfa1096882f91445af2ca0114983e8a77f956bd14148d087655182ed3901f1d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gryf", }, Group:"", SpecialGroup:""},
+  4255037136: Warship{Name:"Atago",PriceGold:10700,Nation:"japan",IsPremium:true,ShipID:4255037136,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC038_ad542cd733f22b5763df118848a9f5705fc9c5b240c99ea30806690cacb56ae6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Atago", }, Group:"", SpecialGroup:""},
+  4248745968: Warship{Name:"Marblehead",PriceGold:3100,Nation:"usa",IsPremium:true,ShipID:4248745968,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC044_c352867beffd79fc91554872bd06796cdb5389feae42cac8b6ca39ca5f12df70.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Marblehead", }, Group:"", SpecialGroup:""},
+  4184750032: Warship{Name:"Acasta",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184750032,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183701456": 36000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD105_a00128861cb125750e2d8679075eb8eb5760df7817f584569ad347d4b7659f97.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Acasta", }, Group:"", SpecialGroup:""},
+  4185798608: Warship{Name:"Wakeful",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185798608,PriceCredit:725000,Tier:4,NextShips:map[string]int64{ "4081039312": 11000,"4184750032": 11500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD104_b98467bbdb7462d8346f8d2cc9e3edcb43ab14ab404706b3b1ec453f9122a8db.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wakeful", }, Group:"", SpecialGroup:""},
+  4188976336: Warship{Name:"Chengan",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4188976336,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187894992": 450, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC101_e2d9c4c1fee42333b79ccb39822709e0784eeda19fd291e6ddf577f600173394.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chengan", }, Group:"", SpecialGroup:""},
+  4185863984: Warship{Name:"Kaiser",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185863984,PriceCredit:774000,Tier:4,NextShips:map[string]int64{ "4184815408": 13000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB104_bfd77b36af9570ac04c6b75e3a958e52438e108502f5e7f9c601763e02966a32.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kaiser", }, Group:"", SpecialGroup:""},
+  4276008656: Warship{Name:"Tone",PriceGold:11000,Nation:"japan",IsPremium:true,ShipID:4276008656,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC018_2ae928170d84b653950e5d294d9a869780e984924b2452f3e84dc2249bbd554b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tone", }, Group:"", SpecialGroup:""},
+  4185896400: Warship{Name:"Komsomolets",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185896400,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799248": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSA104_d66f4e593756266900d10b1adb00aaa6a2053601633be242bd786cc5cccea4b8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Komsomolets", }, Group:"", SpecialGroup:""},
+  4188976880: Warship{Name:"Eritrea",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4188976880,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928304": 300, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC101_98b411e8b93f2c83c8c11ad37341e8a51a0929b126a42d5f96f68ec100fdb2b2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Eritrea", }, Group:"", SpecialGroup:""},
+  4272895696: Warship{Name:"Izumo",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4272895696,PriceCredit:15900000,Tier:9,NextShips:map[string]int64{ "4276041424": 249000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB021_e2fd8e78ea1cfde30df288cd9047b9efc2fa5f00dec96687bc7da70f3ecaf9e3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Izumo", }, Group:"", SpecialGroup:""},
+  4184782608: Warship{Name:"Celebes",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4184782608,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183734032": 36000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC105_9ade09118e1d17640c60b576f1d669c61b1ed0ced05ff7187da5de3b632b3f2e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Celebes", }, Group:"", SpecialGroup:""},
+  4187928528: Warship{Name:"Weymouth",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4187928528,PriceCredit:70000,Tier:2,NextShips:map[string]int64{ "4186879952": 1900,"4187895760": 650, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC102_ccfe569721fe8d8b6486183341847271a152378c2ba29b9ee86acdc9a03df6d0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Weymouth", }, Group:"", SpecialGroup:""},
+  4186847184: Warship{Name:"Valkyrie",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186847184,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185798608": 6000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSD103_0a1656d22fbe0a4d3cf0d4a08285569e0c506d5f11e44d5bd2ab4a04fbc18db1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Valkyrie", }, Group:"", SpecialGroup:""},
+  4187895600: Warship{Name:"V-25",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4187895600,PriceCredit:75000,Tier:2,NextShips:map[string]int64{ "4186847024": 2000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD102_985d3831fa202e83d1cd69ce61ec74ffaaecfa8c7f610674756b9acc5b856e2f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "V-25", }, Group:"", SpecialGroup:""},
+  4186912560: Warship{Name:"Nassau",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186912560,PriceCredit:318000,Tier:3,NextShips:map[string]int64{ "4082054960": 0,"4185863984": 6700, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB103_73dcd3cc1f269a765bc5300e3c9cfbff9a98b6178149152428e513b99243a2f2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nassau", }, Group:"", SpecialGroup:""},
+  4184749776: Warship{Name:"Mutsuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4184749776,PriceCredit:1380000,Tier:5,NextShips:map[string]int64{ "4183701200": 34000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD105_bef8581dbc86b80baeacc78b0154e52cde072f2cfcb42eae68229c2b0254172e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mutsuki", }, Group:"", SpecialGroup:""},
+  4185896656: Warship{Name:"Hōshō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4185896656,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799504": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA104_7d7342a688ea162287c65071f89954cf0720400daa96bccbed5f3a6dc76868c8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hōshō", }, Group:"", SpecialGroup:""},
+  4272830448: Warship{Name:"Fletcher",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4272830448,PriceCredit:12850000,Tier:9,NextShips:map[string]int64{ "4281219056": 236000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD021_195d2174b3a7b43d0075dd2d5f1d6fd7434f85e7e51d5b0aed7ab3e76fe0f704.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fletcher", }, Group:"", SpecialGroup:""},
+  4186912720: Warship{Name:"Bellerophon",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186912720,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185864144": 6700, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB103_6c7791803b29f19cc327147c78f15fae9914b611f0326bcf1b03e6f04b422cba.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bellerophon", }, Group:"", SpecialGroup:""},
+  4185831216: Warship{Name:"Karlsruhe",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185831216,PriceCredit:684000,Tier:4,NextShips:map[string]int64{ "4184782640": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC104_f19a56ce3041cbf965fdbeb2259ee92a683832bdb74d2644fb230194c07a8bcc.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Karlsruhe", }, Group:"", SpecialGroup:""},
+  4183799760: Warship{Name:"Furious",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183799760,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702608": 161000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSA106_aad97ca91783dee47fe04f4ea9cd3eba43043eb1112dfe6cd00317130fd28e32.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Furious", }, Group:"", SpecialGroup:""},
+  4184815408: Warship{Name:"König",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184815408,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766832": 40500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB105_4910e9f204fe1fd41318f81f5b82af0dfd24d14662b132dff1656c41a2488f78.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "König", }, Group:"", SpecialGroup:""},
+  4187928304: Warship{Name:"Nino Bixio",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4187928304,PriceCredit:85000,Tier:2,NextShips:map[string]int64{ "4186879728": 2000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC102_3f853420d52d4c23a0da55e0abe67ff88cbfea5f5ce1f0e763007d54eb90b0c0.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nino Bixio", }, Group:"", SpecialGroup:""},
+  4258182864: Warship{Name:"Chikuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4258182864,PriceCredit:69000,Tier:2,NextShips:map[string]int64{ "4279154384": 1900,"4292753104": 720, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC035_3e13e5aee5d304ac0bcaf453e1b383a9321f0dab690f44894f5cd50735bce28d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chikuma", }, Group:"", SpecialGroup:""},
+  4268635856: Warship{Name:"Kamikaze",PriceGold:2350,Nation:"japan",IsPremium:true,ShipID:4268635856,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD025_b7e23e0c827173f76fd9b11ce98c60647d90c37fccffdc6e6827ab8654452b50.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kamikaze", }, Group:"", SpecialGroup:""},
+  4184782800: Warship{Name:"Emerald",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184782800,PriceCredit:1250000,Tier:5,NextShips:map[string]int64{ "4183734224": 33000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC105_deb18367b92d80b22e77d22163eb525b2f2621af6ff5776470931f33fc756ac4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Emerald", }, Group:"", SpecialGroup:""},
+  4183799600: Warship{Name:"Weser",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4183799600,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702448": 161000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA106_8a34d1a24c8c2cb5af51c49d0a692c1f5180ea6bb5321591d2cf578981b55cda.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Weser", }, Group:"", SpecialGroup:""},
+  4256085712: Warship{Name:"Hashidate",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4256085712,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4258182864": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC037_2070cb19579c77e4fb7586891286347b81eb9f30cd809d10dc3eaf802c546267.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hashidate", }, Group:"", SpecialGroup:""},
+  4184782576: Warship{Name:"Raimondo Montecuccoli",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4184782576,PriceCredit:1240000,Tier:5,NextShips:map[string]int64{ "4183734000": 32500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC105_ca08923327d71989b462fbf62710578a1588e4cb8b0b4bafd958f92138641503.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Raimondo Montecuccoli", }, Group:"", SpecialGroup:""},
+  4186846672: Warship{Name:"Derzki",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186846672,PriceCredit:268000,Tier:3,NextShips:map[string]int64{ "4185798096": 6100, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD103_5bb18f7b0508041b6fbff5e38676bdced361c26188e0c31230f809b54e4d3a7e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Derzki", }, Group:"", SpecialGroup:""},
+  4259231440: Warship{Name:"Zaō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4259231440,PriceCredit:20000000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC034_4ff33cd01dbf1be2998e56d24f0ebd229f96b0f45183925996660da926b0bb2b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Zaō", }, Group:"", SpecialGroup:""},
+  4188977104: Warship{Name:"Black Swan",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4188977104,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928528": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC101_bd8f336d8b9d967dc6072abbb255325cae7195f1b1cffa56348a961363a93158.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Black Swan", }, Group:"", SpecialGroup:""},
+  4185831376: Warship{Name:"Danae",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185831376,PriceCredit:698000,Tier:4,NextShips:map[string]int64{ "4079925200": 12000,"4184782800": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC104_d4477307bd6c1fb11ac346db66187eb02465a98c39b439b56beeba66d7752cf5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Danae", }, Group:"", SpecialGroup:""},
+  4184749872: Warship{Name:"T-22",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184749872,PriceCredit:1320000,Tier:5,NextShips:map[string]int64{ "4183701296": 35000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD105_0dbd8297e68c5ff53bdede55155ea6fb3ed8d023c115b9400e31ef2095a0e8d4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "T-22", }, Group:"", SpecialGroup:""},
+  4185896752: Warship{Name:"Rhein",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185896752,PriceCredit:1000000,Tier:4,NextShips:map[string]int64{ "4183799600": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSA104_2352cc975180fd32ce46aee711d9dc06f4b29b4fa86be6b616324cd22250c2be.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Rhein", }, Group:"", SpecialGroup:""},
+  4184815344: Warship{Name:"Conte di Cavour",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4184815344,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766768": 42000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB105_0ae83e18692ab76e57b1f443b88ba711330ec2972bd5614ac0a496255ebb67f5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Conte di Cavour", }, Group:"", SpecialGroup:""},
+  4187895248: Warship{Name:"Storozhevoi",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4187895248,PriceCredit:75300,Tier:2,NextShips:map[string]int64{ "4186846672": 2350, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD102_20377e63e9c344e2e63bd0b632b17de8da693432daff88c4d06d45151da010b8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Storozhevoi", }, Group:"", SpecialGroup:""},
+  4185831152: Warship{Name:"Alberto di Giussano",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4185831152,PriceCredit:690000,Tier:4,NextShips:map[string]int64{ "4184782576": 10000,"4185863920": 7200, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC104_d350d46d0522dc1a32023b27924de7e438d6549892479e8433437520233ab77d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Alberto di Giussano", }, Group:"", SpecialGroup:""},
+  4264441840: Warship{Name:"Sims",PriceGold:5000,Nation:"usa",IsPremium:true,ShipID:4264441840,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD029_dc20aa41ee113ff63d5d1f38b0fd288d3612fe5c6e3aad03d78c8f7fc31368a8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sims", }, Group:"", SpecialGroup:""},
+  4186879952: Warship{Name:"Caledon",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4186879952,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4185831376": 5600,"4186912720": 2700, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSC103_7171dbacee49e315b624e086b891af757c200c3d66f46fc3eca43a03de1b2032.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Caledon", }, Group:"", SpecialGroup:""},
+  4188976912: Warship{Name:"Van Kinsbergen",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4188976912,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928336": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC101_f772b159d9d9e65326be89b26f8315d9ad74f373d318284b1c5e25b9becac921.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Van Kinsbergen", }, Group:"", SpecialGroup:""},
+  4185863920: Warship{Name:"Dante Alighieri",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4185863920,PriceCredit:775000,Tier:4,NextShips:map[string]int64{ "4184815344": 13000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISB104_b951c7f9e3d4a139ab58361ddd03109c202a06f7e8a646bb8e91c9d476a3bd0d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dante Alighieri", }, Group:"", SpecialGroup:""},
+  4185798480: Warship{Name:"Bourrasque",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185798480,PriceCredit:725000,Tier:4,NextShips:map[string]int64{ "4184749904": 11500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD104_85b7209a9068051be8c9f5bf1435e5375c5734cddad4b6151b307db8b87c0180.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bourrasque", }, Group:"", SpecialGroup:""},
+  4185798448: Warship{Name:"V-170",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4185798448,PriceCredit:710000,Tier:4,NextShips:map[string]int64{ "4184749872": 11000,"4185896752": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD104_1881fe953a9d2c7d99d0700aa3ceb47f40d62ca54d3ad45ac95030e3b79b3863.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "V-170", }, Group:"", SpecialGroup:""},
+  4183799504: Warship{Name:"Ryūjō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4183799504,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702352": 161000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSA106_1d99eef53441172915ef874586aaec0e9e38250f3145e9b00026f7c2c7291460.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ryūjō", }, Group:"", SpecialGroup:""},
+  4269717488: Warship{Name:"Phoenix",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4269717488,PriceCredit:660000,Tier:4,NextShips:map[string]int64{ "4289640432": 10500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC024_a93d18806afc27c105eaacc9c89f7f54da9ced98fa6e2957a393d555ca2371d3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Phoenix", }, Group:"", SpecialGroup:""},
+  4186847024: Warship{Name:"G-101",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186847024,PriceCredit:249000,Tier:3,NextShips:map[string]int64{ "4185798448": 6100, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSD103_c7128beea81bb83db391af8a6ab9ed7ce65629cb05d468420ded858816556eaf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "G-101", }, Group:"", SpecialGroup:""},
+  4186847056: Warship{Name:"Fusilier",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186847056,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185798480": 6000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD103_5bbc21c158efe42b227cb561ac5e42e165c2940008ad1b990d8793f90ab4b56c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fusilier", }, Group:"", SpecialGroup:""},
+  4186879792: Warship{Name:"Kolberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4186879792,PriceCredit:255000,Tier:3,NextShips:map[string]int64{ "4185831216": 5800,"4186912560": 2700, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC103_9bd72e63e422417406b041ffdc25fccaf50d739d6a51ba8bffd6284672639fb9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kolberg", }, Group:"", SpecialGroup:""},
+  4259264496: Warship{Name:"New Mexico",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4259264496,PriceCredit:3450000,Tier:6,NextShips:map[string]int64{ "4286527472": 72500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB034_790c9d33dff4da4e6c94fcb9dc68276a9b6bdff7dba1ef260a37e0f15b45d8f9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "New Mexico", }, Group:"", SpecialGroup:""},
+  4183766992: Warship{Name:"Queen Elizabeth",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4183766992,PriceCredit:3660000,Tier:6,NextShips:map[string]int64{ "4182718416": 80500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB106_85f8505cf2502dffbd60381da6135ba63d777b02827b7d53ce417f4902135673.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Queen Elizabeth", }, Group:"", SpecialGroup:""},
+  4186879824: Warship{Name:"Friant",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186879824,PriceCredit:247000,Tier:3,NextShips:map[string]int64{ "4185831248": 5600,"4186912592": 2850, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC103_22a4ee8a89edceb8063e312a2ae915bf767ea9676a6673eae375656da4d10341.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Friant", }, Group:"", SpecialGroup:""},
+  4184749360: Warship{Name:"Visby",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4184749360,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183700784": 35000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD105_007e82fd894bf85d29fe18f48bffcecaaef508f4aca5932dd8430882e30bfa56.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Visby", }, Group:"", SpecialGroup:""},
+  4184749904: Warship{Name:"Jaguar",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184749904,PriceCredit:1350000,Tier:5,NextShips:map[string]int64{ "4183701328": 35500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD105_156fbbeb53706f03b54acb6bc929f54a0a4babfc5b8bc543fdf671e55ae21e3e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jaguar", }, Group:"", SpecialGroup:""},
+  4183799248: Warship{Name:"Serov",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4183799248,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702096": 161000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSA106_05fb772f0ff5e2a4560a5a858be1db905d3a691a7332fc32e8ad1b13560ef1b7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Serov", }, Group:"", SpecialGroup:""},
+  4184782640: Warship{Name:"Königsberg",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4184782640,PriceCredit:1280000,Tier:5,NextShips:map[string]int64{ "4183734064": 34000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC105_174fb3aaf7e110a67c49bfa00996f345abf8b2ca1e8f9bbdcf117cbc786366e7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Königsberg", }, Group:"", SpecialGroup:""},
+  4184782672: Warship{Name:"Émile Bertin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4184782672,PriceCredit:1200000,Tier:5,NextShips:map[string]int64{ "4183734096": 33500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC105_0a0171cb234f0eca0ed2c9bea7ca94e6273451ce9d4dff1c43695246f92d2349.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Émile Bertin", }, Group:"", SpecialGroup:""},
+  4183799792: Warship{Name:"Ranger",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4183799792,PriceCredit:4500000,Tier:6,NextShips:map[string]int64{ "4181702640": 161000, }, HasDemoProfile:false, IsSpecial:false, Type:"AirCarrier", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASA106_21c2ea782aafeeb3f5a75c83828862c7ed1d6137e3b1845460db64e8e0dd2808.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ranger", }, Group:"", SpecialGroup:""},
+  4184815568: Warship{Name:"Iron Duke",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4184815568,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766992": 45000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB105_33118e9c1f68bbf9b49d3e2a666220f53bf0cc4e6a6e99af83efcd23f501e007.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Iron Duke", }, Group:"", SpecialGroup:""},
+  4186912592: Warship{Name:"Turenne",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4186912592,PriceCredit:314000,Tier:3,NextShips:map[string]int64{ "4185864016": 6600, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSB103_b9b2039178fd0da43537f37600558a8731db46159c0a8cd14f29dd49aefbbb5d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Turenne", }, Group:"", SpecialGroup:""},
+  4186879440: Warship{Name:"Bogatyr",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4186879440,PriceCredit:254000,Tier:3,NextShips:map[string]int64{ "4185830864": 5700,"4186912208": 2700, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC103_9ba8d071a494fb32ffb652a2578ab4841fb4e9b609ea40aeffc9625b85712566.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bogatyr", }, Group:"", SpecialGroup:""},
+  4185797936: Warship{Name:"Klas Horn",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4185797936,PriceCredit:709000,Tier:4,NextShips:map[string]int64{ "4184749360": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD104_dd63b0c85d975ec8e86261cf7f7a025e89ddab7f7c9bdf623408435257ce7104.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Klas Horn", }, Group:"", SpecialGroup:""},
+  4269684432: Warship{Name:"Wakatake",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4269684432,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4291704528": 5100, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD024_419ac86e38917bb8f20e13308e989197d5d4605bd33d51a4e7bfe3dfe91a58a8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wakatake", }, Group:"", SpecialGroup:""},
+  4276041424: Warship{Name:"Yamato",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4276041424,PriceCredit:20600000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB018_bb608ae8c89cac27791627deb721463af6cb68edcf370154c3081db0d386d9db.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yamato", }, Group:"", SpecialGroup:""},
+  4185831248: Warship{Name:"Duguay-Trouin",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4185831248,PriceCredit:661000,Tier:4,NextShips:map[string]int64{ "4184782672": 10500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC104_d50177c5c65715f0fca5be14385a3eb0eee7aa1023ed4d018d706454b741663a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Duguay-Trouin", }, Group:"", SpecialGroup:""},
+  4187928016: Warship{Name:"Novik",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4187928016,PriceCredit:69300,Tier:2,NextShips:map[string]int64{ "4186879440": 2150,"4187895248": 500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC102_775a7f4068b9ca4c98db5b60f8282b2a05fba5f83b9656949679b8498f6c7260.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Novik", }, Group:"", SpecialGroup:""},
+  4186879760: Warship{Name:"Java",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4186879760,PriceCredit:250000,Tier:3,NextShips:map[string]int64{ "4185831184": 5600, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC103_4add32cf0302fd82c6c925942c3c763d9197b761a30603ec113e4ba8935ea352.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Java", }, Group:"", SpecialGroup:""},
+  4274927600: Warship{Name:"Clemson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4274927600,PriceCredit:720000,Tier:4,NextShips:map[string]int64{ "4185896944": 11000,"4280170480": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD019_e6390d769f905f212e17512f60c51ef66a9090bd266af5a0777df2ac9ec6f4bf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Clemson", }, Group:"", SpecialGroup:""},
+  4187895088: Warship{Name:"Tátra",PriceGold:0,Nation:"europe",IsPremium:false,ShipID:4187895088,PriceCredit:72000,Tier:2,NextShips:map[string]int64{ "4186846512": 2000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PWSD102_4b3c1ebf08778c79d96f06d85a4151bc31e57de63d29ef9e10958cd0842ebea5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tátra", }, Group:"", SpecialGroup:""},
+  4187928400: Warship{Name:"Jurien de la Gravière",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4187928400,PriceCredit:70000,Tier:2,NextShips:map[string]int64{ "4186879824": 2150,"4187895632": 650, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC102_69d964787492674420892eadedd7b57d637f4e59db0293b24345263044f6592d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jurien de la Gravière", }, Group:"", SpecialGroup:""},
+  4186846416: Warship{Name:"Phra Ruang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4186846416,PriceCredit:252000,Tier:3,NextShips:map[string]int64{ "4185797840": 5600, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD103_4a7c0a61010701df997f7ab2aba4d7484fbdc797fd700af94443c54db4cd08d2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Phra Ruang", }, Group:"", SpecialGroup:""},
+  4184749264: Warship{Name:"Jianwei",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4184749264,PriceCredit:1320000,Tier:5,NextShips:map[string]int64{ "4183700688": 34500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD105_51993ca1acf2972ced63f23980395e1bd678b23f161f2e209bfd9367ee98331d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Jianwei", }, Group:"", SpecialGroup:""},
+  4185864144: Warship{Name:"Orion",PriceGold:0,Nation:"uk",IsPremium:false,ShipID:4185864144,PriceCredit:765000,Tier:4,NextShips:map[string]int64{ "4184815568": 13000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB104_18ae858e4f1c2ac2c15bee8156754bcc4689e70f03ca487301d73d69729fa317.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Orion", }, Group:"", SpecialGroup:""},
+  4185831184: Warship{Name:"De Ruyter",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4185831184,PriceCredit:700000,Tier:4,NextShips:map[string]int64{ "4184782608": 11500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC104_2dfb4c44cb66dcfeded8ddbcbc309bef0be2ce42b14e9381c48af9512e9a9540.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "De Ruyter", }, Group:"", SpecialGroup:""},
+  4184815056: Warship{Name:"Pyotr Velikiy",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4184815056,PriceCredit:1600000,Tier:5,NextShips:map[string]int64{ "4183766480": 45000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB105_c2ac66795b8038fb1f475a9c597e37630ee3500d5599b20f607ae445769d2a1d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Pyotr Velikiy", }, Group:"", SpecialGroup:""},
+  4186879728: Warship{Name:"Taranto",PriceGold:0,Nation:"italy",IsPremium:false,ShipID:4186879728,PriceCredit:270000,Tier:3,NextShips:map[string]int64{ "4185831152": 5250, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PISC103_aa70f0bc17705c5975ac6cac7692113377f9746f299c73dccd8d560096f336c6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Taranto", }, Group:"", SpecialGroup:""},
+  4267620048: Warship{Name:"Iwaki Alpha",PriceGold:2650,Nation:"japan",IsPremium:true,ShipID:4267620048,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC026_1ea7159a86da92fc638dfff8b0de17702ad8a301d8747a6ec5aec3ff5e3e344b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Iwaki Alpha", }, Group:"", SpecialGroup:""},
+  4187895632: Warship{Name:"Enseigne Gabolde",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4187895632,PriceCredit:65000,Tier:2,NextShips:map[string]int64{ "4186847056": 2100, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSD102_ffa0434a5082c50959c7b581f26c31f17623e7ef4a96ae1863fd2005512223c4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Enseigne Gabolde", }, Group:"", SpecialGroup:""},
+  4188976976: Warship{Name:"Bougainville",PriceGold:0,Nation:"france",IsPremium:false,ShipID:4188976976,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4187928400": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PFSC101_00f353f266a6eb86cad66c3f2223dbbb6cb29ceec27436ebc648e3edaf7c1575.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Bougainville", }, Group:"", SpecialGroup:""},
+  4273911792: Warship{Name:"Des Moines",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4273911792,PriceCredit:19800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC020_6d8433ad54145db2092483c2e1a72af6be632ac638654dff0541c8f54f4106a7.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Des Moines", }, Group:"", SpecialGroup:""},
+  4184782032: Warship{Name:"Chungking",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4184782032,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:true, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSC105_326967d37a50b99d69012acaa45cd2f434f218a477f38f7198d988285a9216fa.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chungking", }, Group:"", SpecialGroup:""},
+  4185797840: Warship{Name:"Shenyang",PriceGold:0,Nation:"pan_asia",IsPremium:false,ShipID:4185797840,PriceCredit:706000,Tier:4,NextShips:map[string]int64{ "4184749264": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PZSD104_4f1f5b3ad225402e9a29f7c0b31f32f2adf436e6aff2283045399ec7ca246d30.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shenyang", }, Group:"", SpecialGroup:""},
+  4266538992: Warship{Name:"Wickes",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4266538992,PriceCredit:250000,Tier:3,NextShips:map[string]int64{ "4274927600": 6000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD027_34d54f831aa583aa965f31021072db38068694cf0ae960f37d50ab6db0c29960.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wickes", }, Group:"", SpecialGroup:""},
+  4185798096: Warship{Name:"Izyaslav",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185798096,PriceCredit:711000,Tier:4,NextShips:map[string]int64{ "4079891920": 11000,"4185896400": 11000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD104_1e906dcb9379316061196bffcbd45620ec0b4dd8ee4f31c5c887a9abaec2e45e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Izyaslav", }, Group:"", SpecialGroup:""},
+  4187928336: Warship{Name:"Gelderland",PriceGold:0,Nation:"netherlands",IsPremium:false,ShipID:4187928336,PriceCredit:75000,Tier:2,NextShips:map[string]int64{ "4186879760": 2200, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PHSC102_418eadbda66da7778bc9d003202719d39609ffff964ff19b0a4c967a6c1c3715.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gelderland", }, Group:"", SpecialGroup:""},
+  4185863632: Warship{Name:"Gangut",PriceGold:0,Nation:"ussr",IsPremium:false,ShipID:4185863632,PriceCredit:765000,Tier:4,NextShips:map[string]int64{ "4184815056": 13000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB104_96d5306fc70a6fb120f8dcf82aa669ac7afa2416793792885a3d51f63255d224.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gangut", }, Group:"", SpecialGroup:""},
+  4289640144: Warship{Name:"Furutaka",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4289640144,PriceCredit:1370000,Tier:5,NextShips:map[string]int64{ "4287542992": 30000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC005_47ad5b79b8925372bf7a4c19383cb3fe5ea2c0f3baa8ac1d394c56f4d6b1790a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Furutaka", }, Group:"", SpecialGroup:""},
+  4288624336: Warship{Name:"Fusō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4288624336,PriceCredit:3490000,Tier:6,NextShips:map[string]int64{ "4284430032": 78500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB006_48eeed44a70f46d7ef59584a03302baab9ca1a8017c3882141cdfe5b2081b38a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Fusō", }, Group:"", SpecialGroup:""},
+  4288559088: Warship{Name:"Mahan",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4288559088,PriceCredit:5350000,Tier:7,NextShips:map[string]int64{ "4286461936": 115000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD006_8809457fd91e0059ae2ad9a12bbbc625f9da78d53447c3aeac7946f7262b720a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mahan", }, Group:"", SpecialGroup:""},
+  4293834736: Warship{Name:"Erie",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4293834736,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4292786160": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC001_ade92dc58a7fb61bdf43dc04baf0f16916ae76371b427930a833b0e0852fbc38.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Erie", }, Group:"", SpecialGroup:""},
+  4283381456: Warship{Name:"Mikasa",PriceGold:2000,Nation:"japan",IsPremium:true,ShipID:4283381456,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB011_d7d3fab61194d998b8ca4e0af8bfc2e624a03695f8a4e3154db5990951910779.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mikasa", }, Group:"", SpecialGroup:""},
+  4290688720: Warship{Name:"Yūbari",PriceGold:2250,Nation:"japan",IsPremium:true,ShipID:4290688720,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC004_6b130f0c0d7114d79c688d7d582a64145c5e3c017b2287b3e8ac8296ae1fb1e6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Yūbari", }, Group:"", SpecialGroup:""},
+  4284397008: Warship{Name:"Diana Lima",PriceGold:1650,Nation:"ussr",IsPremium:true,ShipID:4284397008,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC010_8c8139f5360051823b945ca1759fc1c02ece282de3a08242632a9eec614a793c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Diana Lima", }, Group:"", SpecialGroup:""},
+  4293866960: Warship{Name:"Imperator Nikolai I",PriceGold:2800,Nation:"ussr",IsPremium:true,ShipID:4293866960,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSB001_4579e7d96220de23d97a0f36ff8e1deb462fe30eefca7e225267fe84b3dbd10b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Imperator Nikolai I", }, Group:"", SpecialGroup:""},
+  4292785616: Warship{Name:"Diana",PriceGold:1650,Nation:"ussr",IsPremium:true,ShipID:4292785616,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC002_dd0b07a17617dbed3939446044392489849812497f829017158886130206bb1d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Diana", }, Group:"", SpecialGroup:""},
+  4291704528: Warship{Name:"Isokaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4291704528,PriceCredit:705000,Tier:4,NextShips:map[string]int64{ "4184749776": 11000,"4185896656": 11000,"4290655952": 11500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD003_9afebb2eb020b27e3a1542d4f75ea226036f4f6fb793525c5a121c26c7ec76cb.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Isokaze", }, Group:"", SpecialGroup:""},
+  4291770064: Warship{Name:"Myōgi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4291770064,PriceCredit:775000,Tier:4,NextShips:map[string]int64{ "4287575760": 12000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB003_64e6e131b2406b726d9c50d5bd7fa8d6625f1b428d6851db4ba1c9268539387b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Myōgi", }, Group:"", SpecialGroup:""},
+  4286461936: Warship{Name:"Benson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4286461936,PriceCredit:9040000,Tier:8,NextShips:map[string]int64{ "4272830448": 158000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD008_938a07eb570452670d233040657ecf2eb2f1941dcf444dc2b55f3a495d556c88.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Benson", }, Group:"", SpecialGroup:""},
+  4281219056: Warship{Name:"Gearing",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4281219056,PriceCredit:19500000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD013_cf35f9528df69fef9880f2d65cf467010068268307c5f679cc2f2b2b93bc26d5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gearing", }, Group:"", SpecialGroup:""},
+  4292818896: Warship{Name:"Warspite",PriceGold:6500,Nation:"uk",IsPremium:true,ShipID:4292818896,PriceCredit:0,Tier:6,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PBSB002_e7e5e1e1f977851df1b5ce9dfb5da95216ede42608e9abdb54afcfeadfda4f99.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Warspite", }, Group:"", SpecialGroup:""},
+  4288591856: Warship{Name:"Atlanta",PriceGold:9000,Nation:"usa",IsPremium:true,ShipID:4288591856,PriceCredit:0,Tier:7,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC006_e8351e2a51cd6e4546791ccf2e903498370d5d953836ccc11db81ec903646f7a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Atlanta", }, Group:"", SpecialGroup:""},
+  4292818736: Warship{Name:"Tirpitz",PriceGold:12500,Nation:"germany",IsPremium:true,ShipID:4292818736,PriceCredit:0,Tier:8,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSB002_e7b49ecef4ae0be8382c3ff3c77ad70beeb89bdb90e7dd7a6d82b194c5812260.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tirpitz", }, Group:"", SpecialGroup:""},
+  4292785968: Warship{Name:"Dresden",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4292785968,PriceCredit:67300,Tier:2,NextShips:map[string]int64{ "4186879792": 2200,"4187895600": 500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC002_66ce623a93dfde135c49a146b20de3337863d1760ab813d7bef4808dd3ba2a1c.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Dresden", }, Group:"", SpecialGroup:""},
+  4279154384: Warship{Name:"Tenryū",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4279154384,PriceCredit:256000,Tier:3,NextShips:map[string]int64{ "4281251536": 5600,"4293867216": 2600, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC015_f8fc92f790d19c7292753b60e47c195e3864b7748da34d08d7f28bcd4a4a798d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tenryū", }, Group:"", SpecialGroup:""},
+  4293867216: Warship{Name:"Kawachi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4293867216,PriceCredit:318000,Tier:3,NextShips:map[string]int64{ "4291770064": 6700, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB001_f99d4a487e2eeda442edfb3eeed0feb5cd260d088d2af632f3a804d9a052fdd3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kawachi", }, Group:"", SpecialGroup:""},
+  4289640432: Warship{Name:"Omaha",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4289640432,PriceCredit:1200000,Tier:5,NextShips:map[string]int64{ "4078876656": 38500,"4183734256": 33000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC005_1da1d762b9b557bec30b79e3ccbecf3f2b715121313d8e89ff491edfd9e5263d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Omaha", }, Group:"", SpecialGroup:""},
+  4293834192: Warship{Name:"Aurora",PriceGold:2250,Nation:"ussr",IsPremium:true,ShipID:4293834192,PriceCredit:0,Tier:3,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC001_de2772478db9915d4700ac8941a346a881e793a50cbcdfa4249dd0cf15e3d668.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Aurora", }, Group:"", SpecialGroup:""},
+  4292753104: Warship{Name:"Umikaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4292753104,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4269684432": 2000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD002_31d77318ee9fa56f018282abf1b9c5a12db465e6c38a3174ca7d77d32f1b673d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Umikaze", }, Group:"", SpecialGroup:""},
+  4281251536: Warship{Name:"Kuma",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4281251536,PriceCredit:684000,Tier:4,NextShips:map[string]int64{ "4289640144": 11500, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC013_f2735e49834b31420c33478d2a72d2d5461dbe046e49219a7d121fe4cc44a658.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kuma", }, Group:"", SpecialGroup:""},
+  4281284592: Warship{Name:"Arkansas Beta",PriceGold:2850,Nation:"usa",IsPremium:true,ShipID:4281284592,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB013_fd0062a5f6f66b6d6ac6569219794df971d1463c8fc9f94a3de9592a44be383d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Arkansas Beta", }, Group:"", SpecialGroup:""},
+  4280170192: Warship{Name:"Tachibana Lima",PriceGold:950,Nation:"japan",IsPremium:true,ShipID:4280170192,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD014_74630ef07ccf351532f0e1f334212f1b3c08dceb1885c2ea05b865d81a6046a5.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tachibana Lima", }, Group:"", SpecialGroup:""},
+  4287542992: Warship{Name:"Aoba",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4287542992,PriceCredit:2660000,Tier:6,NextShips:map[string]int64{ "4286494416": 72000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC007_cf448b87cb7dc3c5c66a56feda5608286d7a7ad5980811a844612b239f80f5b8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Aoba", }, Group:"", SpecialGroup:""},
+  4286527184: Warship{Name:"Ishizuchi",PriceGold:2500,Nation:"japan",IsPremium:true,ShipID:4286527184,PriceCredit:0,Tier:4,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB008_1e5831aa5cd00c6c6808c4538edca19902e436a4b16247161a5c0ed46800e2b6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ishizuchi", }, Group:"", SpecialGroup:""},
+  4293867504: Warship{Name:"South Carolina",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4293867504,PriceCredit:309000,Tier:3,NextShips:map[string]int64{ "4290721776": 7200, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB001_a9ac93ce54f839a639eacc67b81ea5d374e2f514883b8dcd8f4addeb868bad22.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "South Carolina", }, Group:"", SpecialGroup:""},
+  4290689008: Warship{Name:"St.\u00a0Louis",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4290689008,PriceCredit:247000,Tier:3,NextShips:map[string]int64{ "4269717488": 5600,"4293867504": 2800, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC004_0741ebfc2130774f8738775d1c226204b3c3daac7191926e0fa9a1a254371bad.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "St.\u00a0Louis", }, Group:"", SpecialGroup:""},
+  4277090288: Warship{Name:"Montana",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4277090288,PriceCredit:20800000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB017_b51c4665351b2b42f323ce938b73fc6b9f221f170bc0596eefbda0d437026597.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Montana", }, Group:"", SpecialGroup:""},
+  4293801680: Warship{Name:"Tachibana",PriceGold:950,Nation:"japan",IsPremium:true,ShipID:4293801680,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD001_1998f44b5aab7fa5c3a229028caec8cf1e042e1586f3e11c1d3d6e57cf17f18d.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Tachibana", }, Group:"", SpecialGroup:""},
+  4286494416: Warship{Name:"Myōkō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4286494416,PriceCredit:5800000,Tier:7,NextShips:map[string]int64{ "4285445840": 109000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC008_7a535a1d0af5888f028d43c6bc6e9906fda362a2de2c59722cc86c3186f9d4ea.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Myōkō", }, Group:"", SpecialGroup:""},
+  4280170480: Warship{Name:"Nicholas",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4280170480,PriceCredit:1300000,Tier:5,NextShips:map[string]int64{ "4289607664": 35500, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD014_e79514135714915cac9a2f2c865b77804c6bff30a0b5e10e9e5b144e5dfbb4ab.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nicholas", }, Group:"", SpecialGroup:""},
+  4282333168: Warship{Name:"North Carolina",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4282333168,PriceCredit:9800000,Tier:8,NextShips:map[string]int64{ "4276041712": 175000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB012_b194c9c021cc698856a3799e28c8a1e26432d91f06838f4cd4d093e553d18442.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "North Carolina", }, Group:"", SpecialGroup:""},
+  4281284304: Warship{Name:"Amagi",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4281284304,PriceCredit:10900000,Tier:8,NextShips:map[string]int64{ "4272895696": 178000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB013_c215682c543ac556b2a48e1bb70172dd10805f174dc63eae0066b70cfd5db1d1.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Amagi", }, Group:"", SpecialGroup:""},
+  4287575760: Warship{Name:"Kongō",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4287575760,PriceCredit:1420000,Tier:5,NextShips:map[string]int64{ "4288624336": 42500, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB007_0a29784ee43dfb5cb28b371e4714fb8babfda90d266a17c49ae01284a7a87350.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Kongō", }, Group:"", SpecialGroup:""},
+  4293834544: Warship{Name:"Hermelin",PriceGold:0,Nation:"germany",IsPremium:false,ShipID:4293834544,PriceCredit:0,Tier:1,NextShips:map[string]int64{ "4292785968": 350, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PGSC001_14099708b79bc1fd1442376ae808df4af10a51a1c0b7b6e676722cdc2dcaaa0e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Hermelin", }, Group:"", SpecialGroup:""},
+  4292753392: Warship{Name:"Sampson",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4292753392,PriceCredit:60000,Tier:2,NextShips:map[string]int64{ "4266538992": 2200, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD002_ce33bc86770e84359455424b0535414ab34cd868867525e0d9984287db93024f.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Sampson", }, Group:"", SpecialGroup:""},
+  4284430032: Warship{Name:"Nagato",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4284430032,PriceCredit:6350000,Tier:7,NextShips:map[string]int64{ "4281284304": 135000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSB010_ff3e7bb339d23360a083bd0da2586e2046561ab58616b89d8ce3c44e392897b3.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Nagato", }, Group:"", SpecialGroup:""},
+  4286527472: Warship{Name:"Colorado",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4286527472,PriceCredit:6000000,Tier:7,NextShips:map[string]int64{ "4181669872": 140000,"4282333168": 120000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB008_f307012b83b46b5701060cfb0d1a3abe4cb3e9ce0a2b6db149d4f93752f182b9.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Colorado", }, Group:"", SpecialGroup:""},
+  4282300112: Warship{Name:"Ibuki",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4282300112,PriceCredit:12500000,Tier:9,NextShips:map[string]int64{ "4259231440": 238000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC012_49a90dabd944968fe21ce60cefb889d49d4562e8be3f0202b2b2b71e0c4481b2.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Ibuki", }, Group:"", SpecialGroup:""},
+  4288624624: Warship{Name:"New York",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4288624624,PriceCredit:1500000,Tier:5,NextShips:map[string]int64{ "4259264496": 40000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB006_23fd08bc3676319adccc69b91c3242226f3d9c1018ffb201b443801a0e1f6656.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "New York", }, Group:"", SpecialGroup:""},
+  4290721776: Warship{Name:"Wyoming",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4290721776,PriceCredit:785000,Tier:4,NextShips:map[string]int64{ "4288624624": 13000, }, HasDemoProfile:false, IsSpecial:false, Type:"Battleship", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASB004_dbd237bb068f2af87183f11156be3a08b65f2c25ddab0b870abb52a6f41711b6.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Wyoming", }, Group:"", SpecialGroup:""},
+  4291737584: Warship{Name:"Albany",PriceGold:1550,Nation:"usa",IsPremium:true,ShipID:4291737584,PriceCredit:0,Tier:2,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC003_4380819e83afd2df986c9ce98ca3ea3b248b8ea2ba0c720350a7530842b024e4.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Albany", }, Group:"", SpecialGroup:""},
+  4282267344: Warship{Name:"Shimakaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4282267344,PriceCredit:19300000,Tier:10,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD012_abe195480c5687e0a492b11dcd83d8117b278c8c3aa8bc794b3df3ed77c21c26.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Shimakaze", }, Group:"", SpecialGroup:""},
+  4292786160: Warship{Name:"Chester",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4292786160,PriceCredit:69300,Tier:2,NextShips:map[string]int64{ "4290689008": 2200,"4292753392": 720, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASC002_c9e819fc252eb57edac28ecc2c968de69803d66415b88a10eca629d8a869fd1e.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Chester", }, Group:"", SpecialGroup:""},
+  4293801424: Warship{Name:"Gremyashchy",PriceGold:2350,Nation:"ussr",IsPremium:true,ShipID:4293801424,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSD001_72d299bc3a0fecbc2887da6c41ae5bb6764db5a7ce921e2e665721f021e9be8b.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Gremyashchy", }, Group:"", SpecialGroup:""},
+  4285445840: Warship{Name:"Mogami",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4285445840,PriceCredit:8800000,Tier:8,NextShips:map[string]int64{ "4282300112": 151000, }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSC009_33e71e78734f0619b10f8c02cbcf7028c774fcf2fda26a6a1a5be811ad036a9a.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Mogami", }, Group:"", SpecialGroup:""},
+  4291737040: Warship{Name:"Murmansk",PriceGold:3000,Nation:"ussr",IsPremium:true,ShipID:4291737040,PriceCredit:0,Tier:5,NextShips:map[string]int64{  }, HasDemoProfile:false, IsSpecial:false, Type:"Cruiser", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PRSC003_12de8888c5c05ba9479ad496193c841281284437bcb2acd42921d2d39ed5aca8.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Murmansk", }, Group:"", SpecialGroup:""},
+  4289607664: Warship{Name:"Farragut",PriceGold:0,Nation:"usa",IsPremium:false,ShipID:4289607664,PriceCredit:3120000,Tier:6,NextShips:map[string]int64{ "4288559088": 65000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PASD005_c503f2cb82bd84759aee1093d7d5b0310afc726e413e6305fbd317026a15e8fd.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Farragut", }, Group:"", SpecialGroup:""},
+  4290655952: Warship{Name:"Minekaze",PriceGold:0,Nation:"japan",IsPremium:false,ShipID:4290655952,PriceCredit:1380000,Tier:5,NextShips:map[string]int64{ "4078843600": 34000, }, HasDemoProfile:false, IsSpecial:false, Type:"Destroyer", Images:Images{Small:"https://glossary-wows-global.gcdn.co/icons//vehicle/small/PJSD004_f07b938240f3700ff09c933d5287b980b64a10461c6411d79f1f467fe02b1aaf.png",Medium:"",Large:"",Contour:""}, LocalizedNames:map[string]string{ "en": "Minekaze", }, Group:"", SpecialGroup:""},
 }
\ No newline at end of file
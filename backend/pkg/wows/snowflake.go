@@ -4,11 +4,8 @@ type Snowflake2019 struct {
 }
 
 func (s Snowflake2019) IsShipEligible(w *Warship) bool {
-	if w.IsTestShip() {
-		return false
-	}
-
-	if w.IsRentalShip() {
+	switch w.Class() {
+	case Test, Rental, ClanRental:
 		return false
 	}
 
@@ -4,11 +4,8 @@ type Snowflake2021 struct {
 }
 
 func (s Snowflake2021) IsShipEligible(w *Warship) bool {
-	if w.IsTestShip() {
-		return false
-	}
-
-	if w.IsRentalShip() {
+	switch w.Class() {
+	case Test, Rental, ClanRental:
 		return false
 	}
 
@@ -6,50 +6,160 @@ import (
 	"strings"
 )
 
+// ShipType is the class of a ship as returned by the encyclopedia API
+type ShipType string
+
+const (
+	Destroyer  ShipType = "Destroyer"
+	Cruiser    ShipType = "Cruiser"
+	Battleship ShipType = "Battleship"
+	AirCarrier ShipType = "AirCarrier"
+	Submarine  ShipType = "Submarine"
+)
+
+// Short returns the abbreviation commonly used by players for the ship type, e.g. "DD"
+func (t ShipType) Short() string {
+	switch t {
+	case Destroyer:
+		return "DD"
+	case Cruiser:
+		return "CA"
+	case Battleship:
+		return "BB"
+	case AirCarrier:
+		return "CV"
+	case Submarine:
+		return "SS"
+	default:
+		return ""
+	}
+}
+
+// SpecialGroup is a group of ships that are variants of other ships, usually released as part of a collaboration
+type SpecialGroup string
+
+const (
+	// NoGroup is used for all regular ships
+	NoGroup SpecialGroup = ""
+	// ARP ships are part of the Arpeggio of Blue Steel collaboration
+	ARP SpecialGroup = "arp"
+	// HSF ships are part of the High School Fleet collaboration
+	HSF SpecialGroup = "hsf"
+	// AzurLane ships are part of the Azur Lane collaboration
+	AzurLane SpecialGroup = "azur_lane"
+	// BVariant ships are "B" copies of existing premium ships, e.g. "Atago B"
+	BVariant SpecialGroup = "b_variant"
+)
+
+// SpecialGroupFromName returns the special group of a ship. The encyclopedia does not expose these groups,
+// so they are derived from the prefix or suffix WG uses in the ship name.
+func SpecialGroupFromName(name string) SpecialGroup {
+	switch {
+	case strings.HasPrefix(name, "ARP "):
+		return ARP
+	case strings.HasPrefix(name, "HSF "):
+		return HSF
+	case strings.HasPrefix(name, "AL "):
+		return AzurLane
+	case strings.HasSuffix(name, " B"):
+		return BVariant
+	default:
+		return NoGroup
+	}
+}
+
+// ShipGroup is the group of a ship in the encyclopedia, which describes how the ship is obtained
+type ShipGroup string
+
+const (
+	// GroupStart ships are the tier 1 ships every player starts with
+	GroupStart ShipGroup = "start"
+	// GroupUpgradeable ships are researched in the tech tree
+	GroupUpgradeable ShipGroup = "upgradeable"
+	// GroupUpgradeableUltimate ships are the last ships of a tech tree line
+	GroupUpgradeableUltimate ShipGroup = "upgradeableUltimate"
+	// GroupUpgradeableExclusive ships are bought with research points
+	GroupUpgradeableExclusive ShipGroup = "upgradeableExclusive"
+	// GroupSuperShip ships are researched from tier 10 ships
+	GroupSuperShip ShipGroup = "superShip"
+	// GroupPremium ships are sold for doubloons or in the premium shop
+	GroupPremium ShipGroup = "premium"
+	// GroupSpecial ships are sold for coal, steel and other resources
+	GroupSpecial ShipGroup = "special"
+	// GroupSpecialUnsellable ships are special ships that can not be sold
+	GroupSpecialUnsellable ShipGroup = "specialUnsellable"
+	// GroupEarlyAccess ships are obtained through early access events before their line is released
+	GroupEarlyAccess ShipGroup = "earlyAccess"
+	// GroupEvent ships are only available during events
+	GroupEvent ShipGroup = "event"
+	// GroupClan ships are rented to players for clan battles
+	GroupClan ShipGroup = "clan"
+	// GroupDemoWithoutStats ships are still being tested
+	GroupDemoWithoutStats ShipGroup = "demoWithoutStats"
+)
+
+// RealmLanguages are the languages the ship names are localized in for each realm
+var RealmLanguages = map[string][]string{
+	"eu":   {"en", "de", "fr", "pl", "es", "cs"},
+	"com":  {"en", "es-mx", "pt-br"},
+	"ru":   {"ru"},
+	"asia": {"en", "ja", "zh-tw", "th"},
+}
+
+// Images contains the URLs of the ship images provided by the encyclopedia
+type Images struct {
+	Small   string `json:"small"`
+	Medium  string `json:"medium"`
+	Large   string `json:"large"`
+	Contour string `json:"contour"`
+}
+
 // Warship represents a WoWS Ship with field relevant to Whaling
 type Warship struct {
-	Name           string           `json:"name"`
-	PriceGold      int              `json:"price_gold"`
-	Nation         string           `json:"nation"`
-	IsPremium      bool             `json:"is_premium"`
-	ShipID         int64            `json:"ship_id"`
-	PriceCredit    int              `json:"price_credit"`
-	Tier           int              `json:"tier"`
-	NextShips      map[string]int64 `json:"next_ships"`
-	HasDemoProfile bool             `json:"has_demo_profile"`
+	Name           string            `json:"name"`
+	PriceGold      int               `json:"price_gold"`
+	Nation         string            `json:"nation"`
+	IsPremium      bool              `json:"is_premium"`
+	ShipID         int64             `json:"ship_id"`
+	PriceCredit    int               `json:"price_credit"`
+	Tier           int               `json:"tier"`
+	NextShips      map[string]int64  `json:"next_ships"`
+	HasDemoProfile bool              `json:"has_demo_profile"`
+	IsSpecial      bool              `json:"is_special"`
+	Type           ShipType          `json:"type"`
+	Images         Images            `json:"images"`
+	LocalizedNames map[string]string `json:"localized_names"`
+	Group          ShipGroup         `json:"group"`
+	SpecialGroup   SpecialGroup      `json:"special_group"`
+}
+
+// LocalizedName returns the name of the ship in the given language, falling back to the english name
+func (w *Warship) LocalizedName(language string) string {
+	if name, ok := w.LocalizedNames[language]; ok && name != "" {
+		return name
+	}
+	return w.Name
+}
+
+// Class returns how the ship can be obtained, see Classify
+func (w *Warship) Class() ShipClass {
+	return Classify(w)
 }
 
 // IsRentalShip returns whether a ship is only available for a limited period of time,
 // such as ships for rent events or clan battles
 func (w *Warship) IsRentalShip() bool {
-	return strings.Contains(w.Name, "[")
+	class := w.Class()
+	return class == Rental || class == ClanRental
 }
 
 // IsTestShip returns whether the ship is currently in testing (WIP ships)
 func (w *Warship) IsTestShip() bool {
-	return w.HasDemoProfile
+	return w.Class() == Test
 }
 
 // GetsPremiumTreatment returns whether the ship is a premium or premium in disguise, like Armory ship,
 // which are treated as Premium ships in the event
 func (w *Warship) GetsPremiumTreatment() bool {
-	if w.IsPremium {
-		return true
-	}
-
-	// ARP Event ships
-	if strings.Contains(w.Name, "ARP ") {
-		return true
-	}
-
-	// Armory premiums (non-T10)
-	if len(w.NextShips) == 0 && w.Tier < 10 {
-		return true
-	}
-
-	if w.PriceCredit == 0 {
-		return true
-	}
-
-	return false
+	return w.Class() != TechTree
 }
@@ -8,4 +8,29 @@ var EventStartTime = map[string]int{
 	"asia": 1637179200,
 }
 
+// EventEndTime is the Unix timestamp when the event ends
+var EventEndTime = map[string]int{
+	"eu":   1642572000,
+	"com":  1642507200,
+	"ru":   1642485600,
+	"asia": 1642536000,
+}
+
 var ActiveEvent = Snowflake2021{}
+
+// RealmFromAccountID returns the realm of an account based on the range its ID is in, or an empty string
+// if the ID is not valid
+func RealmFromAccountID(accountID int64) string {
+	switch {
+	case accountID <= 0:
+		return ""
+	case accountID < 500000000:
+		return "ru"
+	case accountID < 1000000000:
+		return "eu"
+	case accountID < 2000000000:
+		return "com"
+	default:
+		return "asia"
+	}
+}
@@ -8,37 +8,78 @@ export const token = writable(undefined);
 export const dataUrl = writable(undefined);
 export const shipInfo = writable(undefined);
 export const realm = writable(undefined);
+
+// WebSocket API that pushes the progress of refreshes
+export const pushUrl = 'wss://whaling-push.in.fkn.space';
+
+// Resources in the order of their old numeric values, which older data still uses
+export const resourceIds = [
+  'republic_tokens',
+  'coal',
+  'steel',
+  'santa_container',
+  'super_container',
+  'anniversary_camouflage',
+  'anniversary_container',
+  'festive_token',
+  'festive_token_and_anniversary_container',
+  'new_year_certificate',
+];
+
+export const resourceName = {
+  republic_tokens: 'Republic Tokens',
+  coal: 'Coal',
+  steel: 'Steel',
+  santa_container: 'Santa Container',
+  super_container: 'Super Container',
+  anniversary_camouflage: 'Anniversary Camouflage',
+  anniversary_container: 'Anniversary Container',
+  festive_token: 'Festive Token',
+  festive_token_and_anniversary_container:
+    'Festive Token and Anniversary Container',
+  new_year_certificate: 'New Year Certificate',
+};
+
+// resourceId returns the ID of a resource that may still be stored as a number
+export function resourceId(type) {
+  return typeof type === 'number' ? resourceIds[type] : type;
+}
+
+// normalizeData converts subscriber data written before resources had IDs
+export function normalizeData(data) {
+  const resources = {};
+  for (const id of resourceIds) {
+    resources[id] = { Type: id, Amount: 0, Earned: 0 };
+  }
+  for (const res of Object.values(data.Resources || {})) {
+    resources[resourceId(res.Type)] = { ...res, Type: resourceId(res.Type) };
+  }
+  data.Resources = resources;
+
+  for (const ship of Object.values(data.Ships || {})) {
+    ship.Resource.Type = resourceId(ship.Resource.Type);
+  }
+  return data;
+}
+
 export const statistics = writable([
   {
-    Type: 1,
+    Type: 'coal',
     Amount: 0,
     Earned: 0,
   },
   {
-    Type: 2,
+    Type: 'steel',
     Amount: 0,
     Earned: 0,
   },
   {
-    Type: 3,
+    Type: 'new_year_certificate',
     Amount: 0,
     Earned: 0,
   },
 ]);
 
-export const resourceName = [
-  'Republic Tokens',
-  'Coal',
-  'Steel',
-  'Santa Container',
-  'Super Container',
-  'Anniversary Camouflage',
-  'Anniversary Container',
-  'Festive Token',
-  'Festive Token and Anniversary Container',
-  'New Year Certificate',
-];
-
 axios.get('/warships.min.json').then((res) => {
   // transform from array to map
   const ships = {};
@@ -47,9 +88,33 @@ axios.get('/warships.min.json').then((res) => {
   shipInfo.set(ships);
 });
 
-axios.get(`/statistics.json?${new Date().toISOString()}`).then((res) => {
-  if (typeof res.data !== 'object') {
-    return;
-  }
-  statistics.set(res.data);
-});
+// globalStatistics is the versioned statistics document with the breakdowns per realm, tier, nation and ship
+export const globalStatistics = writable(undefined);
+
+axios
+  .get(`/statistics/v1.json?${new Date().toISOString()}`)
+  .then((res) => {
+    if (typeof res.data !== 'object' || res.data.version !== 1) {
+      return;
+    }
+    globalStatistics.set(res.data);
+    statistics.set(
+      resourceIds
+        .filter((id) => res.data.global.resources[id])
+        .map((id) => ({
+          Type: id,
+          Amount: res.data.global.resources[id].amount,
+          Earned: res.data.global.resources[id].earned,
+        }))
+    );
+  })
+  .catch(() =>
+    axios.get(`/statistics.json?${new Date().toISOString()}`).then((res) => {
+      if (typeof res.data !== 'object') {
+        return;
+      }
+      statistics.set(
+        res.data.map((r) => ({ ...r, Type: resourceId(r.Type) }))
+      );
+    })
+  );
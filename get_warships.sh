@@ -1,12 +1,19 @@
 #!/bin/bash
 set -eo pipefail
 
+# Languages the ship names are localized in, see wows.RealmLanguages
+languages="en de fr pl es cs es-mx pt-br ru ja zh-tw th"
+
 get_page_meta() {
 	curl -s "https://api.worldofwarships.eu/wows/encyclopedia/ships/?application_id=80aa6f7e1d5df049c79d9141dd0826ae&limit=100" | jq  '.meta'
 }
 
 get_page() {
-	curl -s "https://api.worldofwarships.eu/wows/encyclopedia/ships/?application_id=80aa6f7e1d5df049c79d9141dd0826ae&fields=name,price_gold,nation,is_premium,ship_id,price_credit,tier,images.small,next_ships,has_demo_profile&limit=100&page_no=${1}" | jq  '.data | to_entries | map(.value)'
+	curl -s "https://api.worldofwarships.eu/wows/encyclopedia/ships/?application_id=80aa6f7e1d5df049c79d9141dd0826ae&fields=name,price_gold,nation,is_premium,ship_id,price_credit,tier,type,images,next_ships,has_demo_profile,is_special,group&limit=100&page_no=${1}" | jq  '.data | to_entries | map(.value)'
+}
+
+get_names_page() {
+	curl -s "https://api.worldofwarships.eu/wows/encyclopedia/ships/?application_id=80aa6f7e1d5df049c79d9141dd0826ae&fields=name,ship_id&language=${2}&limit=100&page_no=${1}" | jq  '.data | to_entries | map(.value)'
 }
 
 max_page="$(get_page_meta | jq '.page_total')"
@@ -19,5 +26,15 @@ for p in $(seq 1 ${max_page}); do
 	ships="$(jq -rnc --argjson current "${ships}" --argjson new "${current_page}" '$current + $new')"
 done
 
+for lang in ${languages}; do
+	for p in $(seq 1 ${max_page}); do
+		names_page="$(get_names_page "$p" "$lang")"
+
+		ships="$(jq -rnc --argjson current "${ships}" --argjson names "${names_page}" --arg lang "${lang}" '
+			($names | map({key: (.ship_id | tostring), value: .name}) | from_entries) as $byId
+			| $current | map(if $byId[(.ship_id | tostring)] then .localized_names[$lang] = $byId[(.ship_id | tostring)] else . end)')"
+	done
+done
+
 echo "${ships}" > backend/pkg/wows/warships.json
 echo "warships.json has been updated"